@@ -1,8 +1,13 @@
 package adapters
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"sort"
+	"sync"
+	"time"
 
 	"chainlink/core/assets"
 	"chainlink/core/store"
@@ -43,8 +48,19 @@ var (
 	TaskTypeWasm = models.MustNewTaskType("wasm")
 	// TaskTypeRandom is the identifier for the Random adapter.
 	TaskTypeRandom = models.MustNewTaskType("random")
+	// TaskTypeTransform is the identifier for the Transform adapter.
+	TaskTypeTransform = models.MustNewTaskType("transform")
 	// TaskTypeCompare is the identifier for the Compare adapter.
 	TaskTypeCompare = models.MustNewTaskType("compare")
+	// TaskTypeMedian is the identifier for the Aggregate adapter using the median.
+	TaskTypeMedian = models.MustNewTaskType(AggregateMedian)
+	// TaskTypeMean is the identifier for the Aggregate adapter using the mean.
+	TaskTypeMean = models.MustNewTaskType(AggregateMean)
+	// TaskTypeMode is the identifier for the Aggregate adapter using the mode.
+	TaskTypeMode = models.MustNewTaskType(AggregateMode)
+	// TaskTypeTrimmedMean is the identifier for the Aggregate adapter using a
+	// trimmed mean.
+	TaskTypeTrimmedMean = models.MustNewTaskType(AggregateTrimmedMean)
 )
 
 // BaseAdapter is the minimum interface required to create an adapter. Only core
@@ -53,6 +69,14 @@ type BaseAdapter interface {
 	Perform(models.RunInput, *store.Store) models.RunOutput
 }
 
+// ContextAdapter is a BaseAdapter whose work can be cancelled. Adapters that
+// wait on the network or the clock should implement it so that timed out and
+// cancelled runs stop promptly.
+type ContextAdapter interface {
+	BaseAdapter
+	PerformContext(context.Context, models.RunInput, *store.Store) models.RunOutput
+}
+
 // PipelineAdapter wraps a BaseAdapter with requirements for execution in the pipeline.
 type PipelineAdapter struct {
 	BaseAdapter
@@ -70,76 +94,200 @@ func (p PipelineAdapter) MinContractPayment() *assets.Link {
 	return p.minContractPayment
 }
 
+// PerformContext performs the wrapped adapter, cancelling it when ctx is done.
+func (p PipelineAdapter) PerformContext(ctx context.Context, input models.RunInput, store *store.Store) models.RunOutput {
+	return Perform(ctx, p.BaseAdapter, input, store)
+}
+
+// Registration describes a core adapter so that For can build it from a
+// TaskSpec without knowing about its concrete type.
+type Registration struct {
+	// TaskType is the identifier used in job specs to select the adapter.
+	TaskType models.TaskType
+	// Factory returns a new, empty adapter into which task params are
+	// unmarshaled.
+	Factory func() BaseAdapter
+	// MinConfs returns the default minimum confirmations for the adapter. If
+	// nil, the node's MinIncomingConfirmations is used.
+	MinConfs func(orm.ConfigReader) uint32
+	// MinContractPayment returns the minimum payment required by the adapter.
+	// If nil, no payment is required.
+	MinContractPayment func(orm.ConfigReader) *assets.Link
+	// DevOnly restricts the adapter to nodes running in dev mode.
+	DevOnly bool
+}
+
+// MinConfsFor returns the minimum confirmations the adapter requires with the
+// given config.
+func (r Registration) MinConfsFor(config orm.ConfigReader) uint32 {
+	if r.MinConfs == nil {
+		return config.MinIncomingConfirmations()
+	}
+	return r.MinConfs(config)
+}
+
+// MinContractPaymentFor returns the minimum payment the adapter requires with
+// the given config.
+func (r Registration) MinContractPaymentFor(config orm.ConfigReader) *assets.Link {
+	if r.MinContractPayment == nil {
+		return assets.NewLink(0)
+	}
+	return r.MinContractPayment(config)
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[models.TaskType]Registration{}
+)
+
+// Register makes an adapter available to For under its TaskType. Adapters
+// compiled into the node register themselves from an init function.
+func Register(r Registration) error {
+	if r.Factory == nil {
+		return fmt.Errorf("adapter %s has no factory", r.TaskType)
+	}
+	tt, err := models.NewTaskType(r.TaskType.String())
+	if err != nil {
+		return err
+	}
+	if len(tt) == 0 {
+		return errors.New("adapter task type cannot be empty")
+	}
+	r.TaskType = tt
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if _, exists := registry[tt]; exists {
+		return fmt.Errorf("adapter %s is already registered", tt)
+	}
+	registry[tt] = r
+	return nil
+}
+
+// MustRegister calls Register and panics if the adapter cannot be registered.
+func MustRegister(r Registration) {
+	if err := Register(r); err != nil {
+		panic(err)
+	}
+}
+
+// Lookup returns the Registration for the given TaskType, if any.
+func Lookup(tt models.TaskType) (Registration, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	r, ok := registry[tt]
+	return r, ok
+}
+
+// Registrations returns every registered adapter, ordered by TaskType.
+func Registrations() []Registration {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	rs := make([]Registration, 0, len(registry))
+	for _, r := range registry {
+		rs = append(rs, r)
+	}
+	sort.Slice(rs, func(i, j int) bool {
+		return rs[i].TaskType < rs[j].TaskType
+	})
+	return rs
+}
+
+func minimumContractPayment(config orm.ConfigReader) *assets.Link {
+	return config.MinimumContractPayment()
+}
+
+func init() {
+	for _, r := range []Registration{
+		{TaskType: TaskTypeCopy, Factory: func() BaseAdapter { return &Copy{} }},
+		{TaskType: TaskTypeEthBool, Factory: func() BaseAdapter { return &EthBool{} }},
+		{TaskType: TaskTypeEthBytes32, Factory: func() BaseAdapter { return &EthBytes32{} }},
+		{TaskType: TaskTypeEthInt256, Factory: func() BaseAdapter { return &EthInt256{} }},
+		{TaskType: TaskTypeEthUint256, Factory: func() BaseAdapter { return &EthUint256{} }},
+		{
+			TaskType:           TaskTypeEthTx,
+			Factory:            func() BaseAdapter { return &EthTx{} },
+			MinContractPayment: minimumContractPayment,
+		},
+		{
+			TaskType:           TaskTypeEthTxABIEncode,
+			Factory:            func() BaseAdapter { return &EthTxABIEncode{} },
+			MinContractPayment: minimumContractPayment,
+			DevOnly:            true,
+		},
+		{TaskType: TaskTypeHTTPGet, Factory: func() BaseAdapter { return &HTTPGet{} }},
+		{TaskType: TaskTypeHTTPPost, Factory: func() BaseAdapter { return &HTTPPost{} }},
+		{TaskType: TaskTypeJSONParse, Factory: func() BaseAdapter { return &JSONParse{} }},
+		{TaskType: TaskTypeMultiply, Factory: func() BaseAdapter { return &Multiply{} }},
+		{TaskType: TaskTypeNoOp, Factory: func() BaseAdapter { return &NoOp{} }},
+		{TaskType: TaskTypeNoOpPend, Factory: func() BaseAdapter { return &NoOpPend{} }},
+		{TaskType: TaskTypeSleep, Factory: func() BaseAdapter { return &Sleep{} }, DevOnly: true},
+		{TaskType: TaskTypeWasm, Factory: func() BaseAdapter { return &Wasm{} }},
+		{TaskType: TaskTypeRandom, Factory: func() BaseAdapter { return &Random{} }},
+		{TaskType: TaskTypeTransform, Factory: func() BaseAdapter { return &Transform{} }},
+		{TaskType: TaskTypeCompare, Factory: func() BaseAdapter { return &Compare{} }},
+		{TaskType: TaskTypeMedian, Factory: func() BaseAdapter { return &Aggregate{Method: AggregateMedian} }},
+		{TaskType: TaskTypeMean, Factory: func() BaseAdapter { return &Aggregate{Method: AggregateMean} }},
+		{TaskType: TaskTypeMode, Factory: func() BaseAdapter { return &Aggregate{Method: AggregateMode} }},
+		{
+			TaskType: TaskTypeTrimmedMean,
+			Factory:  func() BaseAdapter { return &Aggregate{Method: AggregateTrimmedMean, Trim: 0.1} },
+		},
+	} {
+		MustRegister(r)
+	}
+}
+
+// TimeoutError is returned when a task does not finish within its timeout.
+type TimeoutError struct {
+	Timeout time.Duration
+}
+
+func (e TimeoutError) Error() string {
+	return fmt.Sprintf("task timed out after %v", e.Timeout)
+}
+
+// Perform runs the adapter until it returns or ctx is done, whichever happens
+// first. If ctx is done first, the returned RunOutput holds ctx's error.
+//
+// A ContextAdapter is handed ctx to stop its own work, otherwise the adapter
+// is left to finish in the background.
+func Perform(ctx context.Context, adapter BaseAdapter, input models.RunInput, store *store.Store) models.RunOutput {
+	if ca, ok := adapter.(ContextAdapter); ok {
+		return ca.PerformContext(ctx, input, store)
+	}
+
+	done := make(chan models.RunOutput, 1)
+	go func() {
+		done <- adapter.Perform(input, store)
+	}()
+
+	select {
+	case output := <-done:
+		return output
+	case <-ctx.Done():
+		return models.NewRunOutputError(ctx.Err())
+	}
+}
+
 // For determines the adapter type to use for a given task.
 func For(task models.TaskSpec, config orm.ConfigReader, orm *orm.ORM) (*PipelineAdapter, error) {
 	var ba BaseAdapter
 	var err error
-	mic := config.MinIncomingConfirmations()
-	mcp := assets.NewLink(0)
+	var mic uint32
+	var mcp *assets.Link
 
-	switch task.Type {
-	case TaskTypeCopy:
-		ba = &Copy{}
-		err = unmarshalParams(task.Params, ba)
-	case TaskTypeEthBool:
-		ba = &EthBool{}
-		err = unmarshalParams(task.Params, ba)
-	case TaskTypeEthBytes32:
-		ba = &EthBytes32{}
-		err = unmarshalParams(task.Params, ba)
-	case TaskTypeEthInt256:
-		ba = &EthInt256{}
-		err = unmarshalParams(task.Params, ba)
-	case TaskTypeEthUint256:
-		ba = &EthUint256{}
+	if r, ok := Lookup(task.Type); ok {
+		ba = r.Factory()
 		err = unmarshalParams(task.Params, ba)
-	case TaskTypeEthTx:
-		ba = &EthTx{}
-		mcp = config.MinimumContractPayment()
-		err = unmarshalParams(task.Params, ba)
-	case TaskTypeEthTxABIEncode:
-		ba = &EthTxABIEncode{}
-		mcp = config.MinimumContractPayment()
-		err = unmarshalParams(task.Params, ba)
-	case TaskTypeHTTPGet:
-		ba = &HTTPGet{}
-		err = unmarshalParams(task.Params, ba)
-	case TaskTypeHTTPPost:
-		ba = &HTTPPost{}
-		err = unmarshalParams(task.Params, ba)
-	case TaskTypeJSONParse:
-		ba = &JSONParse{}
-		err = unmarshalParams(task.Params, ba)
-	case TaskTypeMultiply:
-		ba = &Multiply{}
-		err = unmarshalParams(task.Params, ba)
-	case TaskTypeNoOp:
-		ba = &NoOp{}
-		err = unmarshalParams(task.Params, ba)
-	case TaskTypeNoOpPend:
-		ba = &NoOpPend{}
-		err = unmarshalParams(task.Params, ba)
-	case TaskTypeSleep:
-		ba = &Sleep{}
-		err = unmarshalParams(task.Params, ba)
-	case TaskTypeWasm:
-		ba = &Wasm{}
-		err = unmarshalParams(task.Params, ba)
-	case TaskTypeRandom:
-		ba = &Random{}
-		err = unmarshalParams(task.Params, ba)
-	case TaskTypeCompare:
-		ba = &Compare{}
-		err = unmarshalParams(task.Params, ba)
-	default:
+		mic = r.MinConfsFor(config)
+		mcp = r.MinContractPaymentFor(config)
+	} else {
 		bt, err := orm.FindBridge(task.Type)
 		if err != nil {
 			return nil, fmt.Errorf("%s is not a supported adapter type", task.Type)
 		}
-		b := Bridge{BridgeType: bt, Params: task.Params}
-		ba = &b
-		mic = b.Confirmations
-		mcp = bt.MinimumContractPayment
+		return ForBridge(task, bt)
 	}
 
 	pa := &PipelineAdapter{
@@ -151,6 +299,26 @@ func For(task models.TaskSpec, config orm.ConfigReader, orm *orm.ORM) (*Pipeline
 	return pa, err
 }
 
+// ForBridge returns the adapter for a task calling the given bridge, such as
+// a bridge that hasn't been saved yet.
+func ForBridge(task models.TaskSpec, bt models.BridgeType) (*PipelineAdapter, error) {
+	b := Bridge{BridgeType: bt, Params: task.Params}
+	if retry := task.Params.Get("retry"); retry.Exists() {
+		var err error
+		if err = json.Unmarshal([]byte(retry.Raw), &b.Retry); err != nil {
+			return nil, err
+		}
+		if b.Params, err = task.Params.Delete("retry"); err != nil {
+			return nil, err
+		}
+	}
+	return &PipelineAdapter{
+		BaseAdapter:        &b,
+		minConfs:           bt.Confirmations,
+		minContractPayment: bt.MinimumContractPayment,
+	}, nil
+}
+
 func unmarshalParams(params models.JSON, dst interface{}) error {
 	bytes, err := params.MarshalJSON()
 	if err != nil {
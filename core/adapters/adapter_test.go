@@ -1,15 +1,19 @@
 package adapters_test
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
 	"chainlink/core/adapters"
 	"chainlink/core/assets"
 	"chainlink/core/internal/cltest"
+	"chainlink/core/store"
 	"chainlink/core/store/models"
+	"chainlink/core/store/orm"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCreatingAdapterWithConfig(t *testing.T) {
@@ -60,3 +64,80 @@ func TestAdapterFor(t *testing.T) {
 		})
 	}
 }
+
+type customAdapter struct {
+	Value string `json:"value"`
+}
+
+func (c *customAdapter) Perform(models.RunInput, *store.Store) models.RunOutput {
+	return models.NewRunOutputCompleteWithResult(c.Value)
+}
+
+func TestRegister_CustomAdapter(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	tt := models.MustNewTaskType("customAdapterForTest")
+	require.NoError(t, adapters.Register(adapters.Registration{
+		TaskType:           tt,
+		Factory:            func() adapters.BaseAdapter { return &customAdapter{} },
+		MinConfs:           func(orm.ConfigReader) uint32 { return 42 },
+		MinContractPayment: func(orm.ConfigReader) *assets.Link { return assets.NewLink(7) },
+	}))
+
+	r, ok := adapters.Lookup(tt)
+	require.True(t, ok)
+	assert.Equal(t, tt, r.TaskType)
+
+	task := models.TaskSpec{Type: tt, Params: cltest.JSONFromString(t, `{"value":"hello"}`)}
+	adapter, err := adapters.For(task, store.Config, store.ORM)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(42), adapter.MinConfs())
+	assert.Equal(t, assets.NewLink(7), adapter.MinContractPayment())
+
+	output := adapter.Perform(models.RunInput{}, nil)
+	assert.Equal(t, "hello", output.Result().String())
+}
+
+func TestRegister_Errors(t *testing.T) {
+	t.Parallel()
+
+	noop := func() adapters.BaseAdapter { return &adapters.NoOp{} }
+	assert.Error(t, adapters.Register(adapters.Registration{TaskType: adapters.TaskTypeNoOp, Factory: noop}))
+	assert.Error(t, adapters.Register(adapters.Registration{TaskType: "", Factory: noop}))
+	assert.Error(t, adapters.Register(adapters.Registration{TaskType: "nofactory"}))
+	assert.Error(t, adapters.Register(adapters.Registration{TaskType: "bad type!", Factory: noop}))
+}
+
+func TestRegistrations_Sorted(t *testing.T) {
+	t.Parallel()
+
+	rs := adapters.Registrations()
+	require.NotEmpty(t, rs)
+	for i := 1; i < len(rs); i++ {
+		assert.True(t, rs[i-1].TaskType < rs[i].TaskType)
+	}
+}
+
+type blockingAdapter struct {
+	release chan struct{}
+}
+
+func (b blockingAdapter) Perform(models.RunInput, *store.Store) models.RunOutput {
+	<-b.release
+	return models.NewRunOutputComplete(models.JSON{})
+}
+
+func TestPerform_AbandonsAdapterWithoutContext(t *testing.T) {
+	t.Parallel()
+
+	adapter := blockingAdapter{release: make(chan struct{})}
+	defer close(adapter.release)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	result := adapters.Perform(ctx, adapter, models.RunInput{}, nil)
+	assert.Equal(t, context.Canceled, result.Error())
+}
@@ -0,0 +1,216 @@
+package adapters
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"chainlink/core/store"
+	"chainlink/core/store/models"
+
+	"github.com/tidwall/gjson"
+)
+
+// Aggregation methods supported by the Aggregate adapter.
+const (
+	AggregateMedian      = "median"
+	AggregateMean        = "mean"
+	AggregateMode        = "mode"
+	AggregateTrimmedMean = "trimmedmean"
+)
+
+// aggregateDecimals is the number of decimal places an aggregated result is
+// rounded to, enough for amounts in wei.
+const aggregateDecimals = 18
+
+var (
+	ErrResultNotArray       = errors.New("The result was not an array")
+	ErrNotEnoughResponses   = errors.New("Not enough valid responses to aggregate")
+	ErrNoUniqueMode         = errors.New("The responses do not have a unique mode")
+	ErrInvalidTrim          = errors.New("Trim must be at least 0 and less than 0.5")
+	ErrInvalidMaxDeviation  = errors.New("Max deviation cannot be negative")
+	ErrUnknownAggregateType = errors.New("Unknown aggregation method")
+)
+
+// Aggregate adapter type reduces an array of numeric results, such as the
+// joined results of several upstream tasks, to a single value.
+//
+// Entries that cannot be parsed as numbers are ignored. When MaxDeviation is
+// set, entries that differ from the median by more than that fraction of the
+// median are rejected as outliers before aggregating. The task errors if fewer
+// than MinResponses entries remain.
+//
+// Entries are aggregated as exact fractions, so that wei-scale values keep
+// every digit. Results with more than 18 decimal places are rounded to 18.
+type Aggregate struct {
+	Method       string  `json:"-"`
+	MinResponses int     `json:"minResponses"`
+	MaxDeviation float64 `json:"maxDeviation"`
+	Trim         float64 `json:"trim"`
+}
+
+// UnmarshalJSON parses the params, rejecting a negative MaxDeviation or a
+// Trim outside [0, 0.5) so that invalid tasks fail when the job is created.
+func (a *Aggregate) UnmarshalJSON(input []byte) error {
+	type plain Aggregate
+	if err := json.Unmarshal(input, (*plain)(a)); err != nil {
+		return err
+	}
+	if a.MaxDeviation < 0 {
+		return ErrInvalidMaxDeviation
+	}
+	if a.Trim < 0 || a.Trim >= 0.5 {
+		return ErrInvalidTrim
+	}
+	return nil
+}
+
+// Perform aggregates the array held in the input's "result" field.
+//
+// For example, with the "median" method an input of ["1.1", "1.2", 9] gives a
+// result of "1.2".
+func (a *Aggregate) Perform(input models.RunInput, _ *store.Store) models.RunOutput {
+	if a.MaxDeviation < 0 {
+		return models.NewRunOutputError(ErrInvalidMaxDeviation)
+	}
+
+	result := input.Result()
+	if !result.IsArray() {
+		return models.NewRunOutputError(ErrResultNotArray)
+	}
+
+	values := []*big.Rat{}
+	for _, r := range result.Array() {
+		if v, ok := parseAggregateEntry(r); ok {
+			values = append(values, v)
+		}
+	}
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].Cmp(values[j]) < 0
+	})
+
+	if a.MaxDeviation > 0 && len(values) > 0 {
+		values = rejectOutliers(values, new(big.Rat).SetFloat64(a.MaxDeviation))
+	}
+
+	minResponses := a.MinResponses
+	if minResponses < 1 {
+		minResponses = 1
+	}
+	if len(values) < minResponses {
+		return models.NewRunOutputError(fmt.Errorf("%v: got %d, need %d", ErrNotEnoughResponses, len(values), minResponses))
+	}
+
+	aggregated, err := a.aggregate(values)
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	return models.NewRunOutputCompleteWithResult(decimalString(aggregated, aggregateDecimals))
+}
+
+func (a *Aggregate) aggregate(sorted []*big.Rat) (*big.Rat, error) {
+	switch a.Method {
+	case AggregateMedian:
+		return median(sorted), nil
+	case AggregateMean:
+		return mean(sorted), nil
+	case AggregateMode:
+		return mode(sorted)
+	case AggregateTrimmedMean:
+		if a.Trim < 0 || a.Trim >= 0.5 {
+			return nil, ErrInvalidTrim
+		}
+		cut := int(float64(len(sorted)) * a.Trim)
+		return mean(sorted[cut : len(sorted)-cut]), nil
+	default:
+		return nil, ErrUnknownAggregateType
+	}
+}
+
+// parseAggregateEntry reads a JSON number or a numeric string, using the raw
+// text of numbers since gjson rounds decimals to a float64.
+func parseAggregateEntry(r gjson.Result) (*big.Rat, bool) {
+	var text string
+	switch r.Type {
+	case gjson.Number:
+		text = r.Raw
+	case gjson.String:
+		text = strings.TrimSpace(r.Str)
+	default:
+		return nil, false
+	}
+	if strings.Contains(text, "/") {
+		return nil, false
+	}
+	return new(big.Rat).SetString(text)
+}
+
+// rejectOutliers drops the values that differ from the median by more than
+// maxDeviation, expressed as a fraction of the median.
+func rejectOutliers(sorted []*big.Rat, maxDeviation *big.Rat) []*big.Rat {
+	m := median(sorted)
+	limit := new(big.Rat).Mul(new(big.Rat).Abs(m), maxDeviation)
+	kept := []*big.Rat{}
+	for _, v := range sorted {
+		deviation := new(big.Rat).Sub(v, m)
+		if deviation.Abs(deviation).Cmp(limit) <= 0 {
+			kept = append(kept, v)
+		}
+	}
+	return kept
+}
+
+func median(sorted []*big.Rat) *big.Rat {
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		sum := new(big.Rat).Add(sorted[mid-1], sorted[mid])
+		return sum.Quo(sum, big.NewRat(2, 1))
+	}
+	return new(big.Rat).Set(sorted[mid])
+}
+
+func mean(values []*big.Rat) *big.Rat {
+	sum := new(big.Rat)
+	for _, v := range values {
+		sum.Add(sum, v)
+	}
+	return sum.Quo(sum, new(big.Rat).SetInt64(int64(len(values))))
+}
+
+func mode(sorted []*big.Rat) (*big.Rat, error) {
+	var best *big.Rat
+	bestCount, count, unique := 0, 0, false
+	for i, v := range sorted {
+		if i > 0 && v.Cmp(sorted[i-1]) == 0 {
+			count++
+		} else {
+			count = 1
+		}
+		if count > bestCount {
+			best, bestCount, unique = v, count, true
+		} else if count == bestCount {
+			unique = false
+		}
+	}
+	if !unique {
+		return nil, ErrNoUniqueMode
+	}
+	return new(big.Rat).Set(best), nil
+}
+
+// decimalString returns the number as an integer, or as a decimal rounded to
+// the given places without trailing zeros.
+func decimalString(r *big.Rat, decimals int) string {
+	if r.IsInt() {
+		return r.Num().String()
+	}
+	s := strings.TrimRight(r.FloatString(decimals), "0")
+	s = strings.TrimSuffix(s, ".")
+	if s == "-0" {
+		return "0"
+	}
+	return s
+}
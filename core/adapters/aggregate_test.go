@@ -0,0 +1,108 @@
+package adapters_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"chainlink/core/adapters"
+	"chainlink/core/internal/cltest"
+	"chainlink/core/store/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregate_Perform(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  string
+		params  string
+		json    string
+		want    string
+		errored bool
+	}{
+		{"median odd", adapters.AggregateMedian, `{}`, `{"result":["3", 1, "2"]}`, "2", false},
+		{"median even", adapters.AggregateMedian, `{}`, `{"result":[4, 1, 3, 2]}`, "2.5", false},
+		{"median ignores invalid", adapters.AggregateMedian, `{}`, `{"result":["1", "x", null, 5, {"a":1}]}`, "3", false},
+		{"median not array", adapters.AggregateMedian, `{}`, `{"result":"1"}`, "", true},
+		{"median empty", adapters.AggregateMedian, `{}`, `{"result":[]}`, "", true},
+		{"median min responses", adapters.AggregateMedian, `{"minResponses":3}`, `{"result":[1, 2, "x"]}`, "", true},
+		{"median outlier", adapters.AggregateMedian, `{"maxDeviation":0.1}`, `{"result":[100, 101, 99, 1000]}`, "100", false},
+		{"median outlier below min", adapters.AggregateMedian, `{"maxDeviation":0.1,"minResponses":4}`, `{"result":[100, 101, 99, 1000]}`, "", true},
+		{"median wei", adapters.AggregateMedian, `{}`, `{"result":["1234500000000000000001", 1234500000000000000003]}`, "1234500000000000000002", false},
+		{"median decimals", adapters.AggregateMedian, `{}`, `{"result":[0.10000000000000000001, "0.10000000000000000003"]}`, "0.1", false},
+		{"mean", adapters.AggregateMean, `{}`, `{"result":[1, "2", 3.5, 5.5]}`, "3", false},
+		{"mean outlier", adapters.AggregateMean, `{"maxDeviation":0.5}`, `{"result":[10, 12, 14, 100]}`, "12", false},
+		{"mode", adapters.AggregateMode, `{}`, `{"result":[1, 2, 2, 3]}`, "2", false},
+		{"mean wei", adapters.AggregateMean, `{}`, `{"result":[1000000000000000000001, 1000000000000000000002, 1000000000000000000006]}`, "1000000000000000000003", false},
+		{"mode wei", adapters.AggregateMode, `{}`, `{"result":[12345678901234567891, "12345678901234567891", 12345678901234567890]}`, "12345678901234567891", false},
+		{"mode tie", adapters.AggregateMode, `{}`, `{"result":[1, 1, 2, 2]}`, "", true},
+		{"trimmed mean", adapters.AggregateTrimmedMean, `{"trim":0.2}`, `{"result":[1, 2, 3, 4, 100]}`, "3", false},
+		{"trimmed mean no trim", adapters.AggregateTrimmedMean, `{"trim":0}`, `{"result":[1, 2, 3, 4, 100]}`, "22", false},
+		{"unknown method", "", `{}`, `{"result":[1]}`, "", true},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			input := cltest.NewRunInputWithString(t, test.json)
+			adapter := adapters.Aggregate{Method: test.method}
+			require.NoError(t, json.Unmarshal([]byte(test.params), &adapter))
+			result := adapter.Perform(input, nil)
+
+			if test.errored {
+				assert.Error(t, result.Error())
+			} else {
+				require.NoError(t, result.Error())
+				assert.Equal(t, test.want, result.Result().String())
+			}
+		})
+	}
+}
+
+func TestAggregate_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name   string
+		params string
+		want   error
+	}{
+		{"valid", `{"maxDeviation":0.1,"trim":0.2}`, nil},
+		{"negative deviation", `{"maxDeviation":-1}`, adapters.ErrInvalidMaxDeviation},
+		{"negative trim", `{"trim":-0.1}`, adapters.ErrInvalidTrim},
+		{"half trim", `{"trim":0.5}`, adapters.ErrInvalidTrim},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			var adapter adapters.Aggregate
+			assert.Equal(t, test.want, json.Unmarshal([]byte(test.params), &adapter))
+		})
+	}
+}
+
+func TestAggregate_For(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	task := models.TaskSpec{
+		Type:   adapters.TaskTypeTrimmedMean,
+		Params: cltest.JSONFromString(t, `{"minResponses": 2}`),
+	}
+	pa, err := adapters.For(task, store.Config, store.ORM)
+	require.NoError(t, err)
+
+	adapter, ok := pa.BaseAdapter.(*adapters.Aggregate)
+	require.True(t, ok)
+	assert.Equal(t, adapters.AggregateTrimmedMean, adapter.Method)
+	assert.Equal(t, 0.1, adapter.Trim)
+	assert.Equal(t, 2, adapter.MinResponses)
+
+	task.Params = cltest.JSONFromString(t, `{"trim": 0.5}`)
+	_, err = adapters.For(task, store.Config, store.ORM)
+	assert.Equal(t, adapters.ErrInvalidTrim, err)
+}
@@ -2,14 +2,18 @@ package adapters
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strconv"
+	"time"
 
-	"chainlink/core/store"
+	strpkg "chainlink/core/store"
 	"chainlink/core/store/models"
+	"chainlink/core/utils"
 
 	"github.com/pkg/errors"
 )
@@ -19,6 +23,7 @@ import (
 type Bridge struct {
 	models.BridgeType
 	Params models.JSON
+	Retry  RetryPolicy
 }
 
 // Perform sends a POST request containing the JSON of the input to the
@@ -28,16 +33,22 @@ type Bridge struct {
 //
 // If the Perform is resumed with a pending RunResult, the RunResult is marked
 // not pending and the RunResult is returned.
-func (ba *Bridge) Perform(input models.RunInput, store *store.Store) models.RunOutput {
+func (ba *Bridge) Perform(input models.RunInput, store *strpkg.Store) models.RunOutput {
+	return ba.PerformContext(context.Background(), input, store)
+}
+
+// PerformContext is Perform, abandoning the request to the external adapter
+// when ctx is done.
+func (ba *Bridge) PerformContext(ctx context.Context, input models.RunInput, store *strpkg.Store) models.RunOutput {
 	if input.Status().Completed() {
 		return models.NewRunOutputComplete(input.Data())
 	} else if input.Status().PendingBridge() {
 		return models.NewRunOutputInProgress(input.Data())
 	}
-	return ba.handleNewRun(input, store.Config.BridgeResponseURL())
+	return ba.handleNewRun(ctx, input, store.Config.BridgeResponseURL(), store.Clock, store.HTTPClients)
 }
 
-func (ba *Bridge) handleNewRun(input models.RunInput, bridgeResponseURL *url.URL) models.RunOutput {
+func (ba *Bridge) handleNewRun(ctx context.Context, input models.RunInput, bridgeResponseURL *url.URL, clock utils.Afterer, clients *strpkg.HTTPClientPool) models.RunOutput {
 	data, err := models.Merge(input.Data(), ba.Params)
 	if err != nil {
 		return models.NewRunOutputError(baRunResultError("handling data param", err))
@@ -46,15 +57,20 @@ func (ba *Bridge) handleNewRun(input models.RunInput, bridgeResponseURL *url.URL
 	responseURL := bridgeResponseURL
 	if *responseURL != *zeroURL {
 		responseURL.Path += fmt.Sprintf("/v2/runs/%s", input.JobRunID().String())
+		if taskRunID := input.TaskRunID(); taskRunID != nil {
+			responseURL.RawQuery = url.Values{"taskRunId": {taskRunID.String()}}.Encode()
+		}
 	}
 
-	body, err := ba.postToExternalAdapter(input, responseURL)
-	if err != nil {
-		return models.NewRunOutputError(baRunResultError("post to external adapter", err))
-	}
+	return ba.Retry.Perform(ctx, clock, func() (models.RunOutput, error) {
+		body, err := ba.postToExternalAdapter(ctx, input, responseURL, clients)
+		if err != nil {
+			return models.RunOutput{}, errors.Wrap(err, "ExternalBridge post to external adapter")
+		}
 
-	input = *models.NewRunInput(input.JobRunID(), data, input.Status())
-	return ba.responseToRunResult(body, input)
+		input := *models.NewRunInput(input.JobRunID(), data, input.Status())
+		return ba.responseToRunResult(body, input), nil
+	})
 }
 
 func (ba *Bridge) responseToRunResult(body []byte, input models.RunInput) models.RunOutput {
@@ -84,7 +100,7 @@ func (ba *Bridge) responseToRunResult(body []byte, input models.RunInput) models
 	return models.NewRunOutputCompleteWithResult(brr.Data.String())
 }
 
-func (ba *Bridge) postToExternalAdapter(input models.RunInput, bridgeResponseURL *url.URL) ([]byte, error) {
+func (ba *Bridge) postToExternalAdapter(ctx context.Context, input models.RunInput, bridgeResponseURL *url.URL, clients *strpkg.HTTPClientPool) ([]byte, error) {
 	data, err := models.Merge(input.Data(), ba.Params)
 	if err != nil {
 		return nil, errors.Wrap(err, "error merging bridge params with input params")
@@ -103,25 +119,45 @@ func (ba *Bridge) postToExternalAdapter(input models.RunInput, bridgeResponseURL
 	if err != nil {
 		return nil, fmt.Errorf("building outgoing bridge http post: %v", err)
 	}
+	request = request.WithContext(ctx)
 	request.Header.Set("Authorization", "Bearer "+ba.BridgeType.OutgoingToken)
 	request.Header.Set("Content-Type", "application/json")
+	if ba.BridgeType.Signed() {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		signature := models.SignBridgeMessage(ba.BridgeType.SigningSecret, timestamp, input.JobRunID(), input.TaskRunID(), in)
+		request.Header.Set(models.BridgeTimestampHeader, timestamp)
+		request.Header.Set(models.BridgeSignatureHeader, signature)
+	}
 
-	client := http.Client{}
+	client, err := clients.Client(ba.httpClientOptions())
+	if err != nil {
+		return nil, errors.Wrap(err, "building bridge http client")
+	}
 	resp, err := client.Do(request)
 	if err != nil {
-		return nil, fmt.Errorf("POST request: %v", err)
+		return nil, errors.Wrap(err, "POST request")
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode >= 400 {
 		b, _ := ioutil.ReadAll(resp.Body)
-		err = fmt.Errorf("%v %v", resp.StatusCode, string(b))
-		return nil, fmt.Errorf("POST response: %v", err)
+		err = &HTTPResponseError{StatusCode: resp.StatusCode, Body: fmt.Sprintf("%v %v", resp.StatusCode, string(b))}
+		return nil, errors.Wrap(err, "POST response")
 	}
 
 	return ioutil.ReadAll(resp.Body)
 }
 
+func (ba *Bridge) httpClientOptions() strpkg.HTTPClientOptions {
+	return strpkg.HTTPClientOptions{
+		Timeout:        ba.BridgeType.Timeout.Duration(),
+		ClientCertPath: ba.BridgeType.ClientCertPath,
+		ClientKeyPath:  ba.BridgeType.ClientKeyPath,
+		CABundlePath:   ba.BridgeType.CABundlePath,
+		ProxyURL:       ba.BridgeType.ProxyURL,
+	}
+}
+
 func baRunResultError(str string, err error) error {
 	return fmt.Errorf("ExternalBridge %v: %v", str, err)
 }
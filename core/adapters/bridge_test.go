@@ -3,7 +3,9 @@ package adapters_test
 import (
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"chainlink/core/adapters"
 	"chainlink/core/internal/cltest"
@@ -178,3 +180,93 @@ func TestBridge_Perform_responseURL(t *testing.T) {
 		})
 	}
 }
+
+func TestBridge_Perform_Signed(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	input := cltest.NewRunInputWithResult("lot 49")
+	_, bt := cltest.NewBridgeType(t, "auctionBidding")
+	signed := true
+	bt.UpdateSigning(&models.BridgeTypeRequest{Signed: &signed})
+
+	mock, ensureCalled := cltest.NewHTTPMockServer(t, http.StatusOK, "POST", `{"data":{"result":"100"}}`,
+		func(h http.Header, body string) {
+			timestamp := h.Get(models.BridgeTimestampHeader)
+			require.NotEmpty(t, timestamp)
+			expected := models.SignBridgeMessage(bt.SigningSecret, timestamp, input.JobRunID(), input.TaskRunID(), []byte(body))
+			assert.Equal(t, expected, h.Get(models.BridgeSignatureHeader))
+		})
+	defer ensureCalled()
+
+	bt.URL = cltest.WebURL(t, mock.URL)
+	eb := &adapters.Bridge{BridgeType: *bt}
+	result := eb.Perform(input, store)
+	require.NoError(t, result.Error())
+	assert.Equal(t, "100", result.Result().String())
+}
+
+func TestBridge_Perform_Unsigned(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	mock, ensureCalled := cltest.NewHTTPMockServer(t, http.StatusOK, "POST", `{"data":{"result":"100"}}`,
+		func(h http.Header, _ string) {
+			assert.Empty(t, h.Get(models.BridgeTimestampHeader))
+			assert.Empty(t, h.Get(models.BridgeSignatureHeader))
+		})
+	defer ensureCalled()
+
+	_, bt := cltest.NewBridgeType(t, "auctionBidding", mock.URL)
+	eb := &adapters.Bridge{BridgeType: *bt}
+	result := eb.Perform(cltest.NewRunInputWithResult("lot 49"), store)
+	require.NoError(t, result.Error())
+}
+
+func TestBridge_Perform_Timeout(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-done
+	}))
+	defer server.Close()
+	defer close(done)
+
+	_, bt := cltest.NewBridgeType(t, "slowbridge", server.URL)
+	bt.Timeout = models.Duration(100 * time.Millisecond)
+	eb := &adapters.Bridge{BridgeType: *bt}
+
+	start := time.Now()
+	result := eb.Perform(cltest.NewRunInputWithResult("lot 49"), store)
+	assert.True(t, result.HasError())
+	assert.True(t, time.Since(start) < 5*time.Second)
+}
+
+func TestBridge_Perform_BlockedHost(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	store.Config.Set("HTTP_ALLOWED_HOSTS", "*.example.com")
+
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	_, bt := cltest.NewBridgeType(t, "internalbridge", server.URL)
+	eb := &adapters.Bridge{BridgeType: *bt}
+	result := eb.Perform(cltest.NewRunInputWithResult("lot 49"), store)
+	require.Error(t, result.Error())
+	assert.Contains(t, result.Error().Error(), "not allowed by the node's outbound network configuration")
+	assert.False(t, called)
+}
@@ -15,6 +15,21 @@
 // adapter will save `true` or `false` in the task run's result.
 //  { "type": "Compare", "params": {"operator": "eq", "value": "Hello" }}
 //
+// Giving the Compare task a "taskId", other tasks can name it as their
+// "condition" to only run when the result is true, or, prefixed with "!",
+// false. Tasks whose condition is not met are skipped.
+//  { "type": "EthTx", "condition": "deviated" }
+//
+// Median, Mean, Mode, TrimmedMean
+//
+// The aggregation adapters reduce an array of numeric results, such as the
+// joined results of several HTTPGet tasks, to a single value. Entries that are
+// not numbers are ignored. "minResponses" sets how many valid entries are
+// required, and "maxDeviation" rejects entries further than that fraction from
+// the median. TrimmedMean drops the "trim" fraction (0.1 by default) of entries
+// from each end before averaging.
+//  { "type": "Median", "params": {"minResponses": 3, "maxDeviation": 0.05 }}
+//
 // HTTPGet
 //
 // The HTTPGet adapter is used to grab the JSON data from the given URL.
@@ -25,11 +40,27 @@
 // Sends a POST request to the specified URL and will return the response.
 //  { "type": "HTTPPost", "params": {"post": "https://weiwatchers.com/api" }}
 //
+// HTTPGet, HTTPPost and bridges accept a "retry" param to retry failed
+// requests with exponential backoff. Requests that cannot connect are always
+// retried, responses only when their status code is listed ("statusCodes"
+// defaults to 429, 500, 502, 503 and 504). The number of attempts and the last
+// error are recorded on the task run.
+//  { "type": "HTTPGet", "params": {"get": "https://some-api-example.net/api",
+//    "retry": {"maxAttempts": 3, "initialBackoff": "1s", "maxBackoff": "10s" }}}
+//
 // JSONParse
 //
 // The JSONParse adapter will obtain the value(s) for the given field(s).
 //  { "type": "JSONParse", "params": {"path": ["someField"] }}
 //
+// Transform
+//
+// The Transform adapter sets the result to the value of an expression over
+// the run's data, using exact arithmetic on numbers of any size. Besides +, -,
+// * and /, expressions can call pow, min, max, abs, round (with a rounding
+// mode), floor, ceil, trunc, concat, format, hex and fromhex.
+//  { "type": "Transform", "params": {"expression": "round((result - data.base) / pow(10, 18), 2)" }}
+//
 // EthBool
 //
 // The EthBool adapter will take the given values and format them for
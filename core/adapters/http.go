@@ -2,8 +2,8 @@ package adapters
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -12,7 +12,7 @@ import (
 	"path"
 	"strings"
 
-	"chainlink/core/store"
+	strpkg "chainlink/core/store"
 	"chainlink/core/store/models"
 	"chainlink/core/utils"
 )
@@ -24,16 +24,24 @@ type HTTPGet struct {
 	Headers      http.Header     `json:"headers"`
 	QueryParams  QueryParameters `json:"queryParams"`
 	ExtendedPath ExtendedPath    `json:"extPath"`
+	Retry        RetryPolicy     `json:"retry"`
 }
 
 // Perform ensures that the adapter's URL responds to a GET request without
 // errors and returns the response body as the "value" field of the result.
-func (hga *HTTPGet) Perform(input models.RunInput, store *store.Store) models.RunOutput {
-	request, err := hga.GetRequest()
-	if err != nil {
-		return models.NewRunOutputError(err)
-	}
-	return sendRequest(input, request, store.Config.DefaultHTTPLimit())
+func (hga *HTTPGet) Perform(input models.RunInput, store *strpkg.Store) models.RunOutput {
+	return hga.PerformContext(context.Background(), input, store)
+}
+
+// PerformContext is Perform, abandoning the request when ctx is done.
+func (hga *HTTPGet) PerformContext(ctx context.Context, input models.RunInput, store *strpkg.Store) models.RunOutput {
+	return hga.Retry.Perform(ctx, store.Clock, func() (models.RunOutput, error) {
+		request, err := hga.GetRequest()
+		if err != nil {
+			return models.RunOutput{}, err
+		}
+		return sendRequest(store, request.WithContext(ctx))
+	})
 }
 
 // GetURL retrieves the GET field if set otherwise returns the URL field
@@ -64,16 +72,24 @@ type HTTPPost struct {
 	QueryParams  QueryParameters `json:"queryParams"`
 	Body         *string         `json:"body,omitempty"`
 	ExtendedPath ExtendedPath    `json:"extPath"`
+	Retry        RetryPolicy     `json:"retry"`
 }
 
 // Perform ensures that the adapter's URL responds to a POST request without
 // errors and returns the response body as the "value" field of the result.
-func (hpa *HTTPPost) Perform(input models.RunInput, store *store.Store) models.RunOutput {
-	request, err := hpa.GetRequest(input.Data().String())
-	if err != nil {
-		return models.NewRunOutputError(err)
-	}
-	return sendRequest(input, request, store.Config.DefaultHTTPLimit())
+func (hpa *HTTPPost) Perform(input models.RunInput, store *strpkg.Store) models.RunOutput {
+	return hpa.PerformContext(context.Background(), input, store)
+}
+
+// PerformContext is Perform, abandoning the request when ctx is done.
+func (hpa *HTTPPost) PerformContext(ctx context.Context, input models.RunInput, store *strpkg.Store) models.RunOutput {
+	return hpa.Retry.Perform(ctx, store.Clock, func() (models.RunOutput, error) {
+		request, err := hpa.GetRequest(input.Data().String())
+		if err != nil {
+			return models.RunOutput{}, err
+		}
+		return sendRequest(store, request.WithContext(ctx))
+	})
 }
 
 // GetURL retrieves the POST field if set otherwise returns the URL field
@@ -132,30 +148,30 @@ func setHeaders(request *http.Request, headers http.Header, contentType string)
 	}
 }
 
-func sendRequest(input models.RunInput, request *http.Request, limit int64) models.RunOutput {
-	tr := &http.Transport{
-		DisableCompression: true,
+func sendRequest(store *strpkg.Store, request *http.Request) (models.RunOutput, error) {
+	client, err := store.HTTPClients.Client(strpkg.HTTPClientOptions{DisableCompression: true})
+	if err != nil {
+		return models.RunOutput{}, err
 	}
-	client := &http.Client{Transport: tr}
 	response, err := client.Do(request)
 	if err != nil {
-		return models.NewRunOutputError(err)
+		return models.RunOutput{}, err
 	}
 
 	defer response.Body.Close()
 
-	source := newMaxBytesReader(response.Body, limit)
+	source := newMaxBytesReader(response.Body, store.Config.DefaultHTTPLimit())
 	bytes, err := ioutil.ReadAll(source)
 	if err != nil {
-		return models.NewRunOutputError(err)
+		return models.RunOutput{}, err
 	}
 
 	responseBody := string(bytes)
 	if response.StatusCode >= 400 {
-		return models.NewRunOutputError(errors.New(responseBody))
+		return models.RunOutput{}, &HTTPResponseError{StatusCode: response.StatusCode, Body: responseBody}
 	}
 
-	return models.NewRunOutputCompleteWithResult(responseBody)
+	return models.NewRunOutputCompleteWithResult(responseBody), nil
 }
 
 // maxBytesReader is inspired by
@@ -1,9 +1,12 @@
 package adapters_test
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"chainlink/core/adapters"
 	"chainlink/core/internal/cltest"
@@ -16,7 +19,8 @@ import (
 )
 
 func leanStore() *store.Store {
-	return &store.Store{Config: orm.NewConfig()}
+	config := orm.NewConfig()
+	return &store.Store{Config: config, HTTPClients: store.NewHTTPClientPool(config)}
 }
 
 func TestHttpAdapters_NotAUrlError(t *testing.T) {
@@ -105,7 +109,7 @@ func TestHTTPGet_Perform(t *testing.T) {
 func TestHTTP_TooLarge(t *testing.T) {
 	cfg := orm.NewConfig()
 	cfg.Set("DEFAULT_HTTP_LIMIT", "1")
-	store := &store.Store{Config: cfg}
+	store := &store.Store{Config: cfg, HTTPClients: store.NewHTTPClientPool(cfg)}
 
 	tests := []struct {
 		verb    string
@@ -131,10 +135,72 @@ func TestHTTP_TooLarge(t *testing.T) {
 	}
 }
 
+func TestHttpAdapters_BlockedAddress(t *testing.T) {
+	t.Parallel()
+
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	cfg := orm.NewConfig()
+	cfg.Set("HTTP_BLOCKED_CIDRS", "127.0.0.0/8")
+	store := &store.Store{Config: cfg, HTTPClients: store.NewHTTPClientPool(cfg)}
+
+	tests := []struct {
+		name    string
+		adapter adapters.BaseAdapter
+	}{
+		{"HTTPGet", &adapters.HTTPGet{URL: cltest.WebURL(t, server.URL)}},
+		{"HTTPPost", &adapters.HTTPPost{URL: cltest.WebURL(t, server.URL)}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := test.adapter.Perform(models.RunInput{}, store)
+			require.Error(t, result.Error())
+			assert.Contains(t, result.Error().Error(), "not allowed by the node's outbound network configuration")
+		})
+	}
+	assert.False(t, called)
+}
+
 func stringRef(str string) *string {
 	return &str
 }
 
+func TestHttpAdapters_PerformContext_Cancelled(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	store := leanStore()
+	tests := []struct {
+		name    string
+		adapter adapters.ContextAdapter
+	}{
+		{"HTTPGet", &adapters.HTTPGet{URL: cltest.WebURL(t, server.URL)}},
+		{"HTTPPost", &adapters.HTTPPost{URL: cltest.WebURL(t, server.URL)}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			result := test.adapter.PerformContext(ctx, models.RunInput{}, store)
+			require.Error(t, result.Error())
+			assert.Contains(t, result.Error().Error(), context.DeadlineExceeded.Error())
+		})
+	}
+}
+
 func TestHttpPost_Perform(t *testing.T) {
 	t.Parallel()
 
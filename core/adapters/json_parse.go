@@ -1,8 +1,10 @@
 package adapters
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -11,12 +13,27 @@ import (
 	"chainlink/core/utils"
 
 	simplejson "github.com/bitly/go-simplejson"
+	"github.com/jmespath/go-jmespath"
 )
 
 // JSONParse holds a path to the desired field in a JSON object,
-// made up of an array of strings.
+// made up of an array of strings, or a JMESPath query selecting it.
 type JSONParse struct {
-	Path JSONPath `json:"path"`
+	Path  JSONPath   `json:"path"`
+	Query *JSONQuery `json:"query,omitempty"`
+}
+
+// UnmarshalJSON implements the Unmarshaler interface, rejecting params that
+// give both a path and a query.
+func (jpa *JSONParse) UnmarshalJSON(b []byte) error {
+	type Alias JSONParse
+	if err := json.Unmarshal(b, (*Alias)(jpa)); err != nil {
+		return err
+	}
+	if jpa.Query != nil && len(jpa.Path) > 0 {
+		return errors.New("JSONParse accepts either a path or a query, not both")
+	}
+	return nil
 }
 
 // Perform returns the value associated to the desired field for a
@@ -30,13 +47,19 @@ type JSONParse struct {
 //     ]
 //   }
 //
-// Then ["0","last"] would be the path, and "111" would be the returned value
+// Then ["0","last"] would be the path, and "111" would be the returned value.
+// The query "data[?last == '2222'].last | [0]" would return "2222",
+// wherever it is in the array.
 func (jpa *JSONParse) Perform(input models.RunInput, _ *store.Store) models.RunOutput {
 	val, err := input.ResultString()
 	if err != nil {
 		return models.NewRunOutputError(err)
 	}
 
+	if jpa.Query != nil {
+		return jpa.Query.perform(val)
+	}
+
 	js, err := simplejson.NewJson([]byte(val))
 	if err != nil {
 		return models.NewRunOutputError(err)
@@ -132,3 +155,82 @@ func (jp *JSONPath) UnmarshalJSON(b []byte) error {
 	*jp = JSONPath(strs)
 	return err
 }
+
+// JSONQuery is a JMESPath expression selecting a value in a JSON object, as
+// described at http://jmespath.org/specification.html
+type JSONQuery struct {
+	expression string
+	compiled   *jmespath.JMESPath
+}
+
+// NewJSONQuery compiles the JMESPath expression.
+func NewJSONQuery(expression string) (*JSONQuery, error) {
+	compiled, err := jmespath.Compile(expression)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JSONParse query %q: %v", expression, err)
+	}
+	return &JSONQuery{expression: expression, compiled: compiled}, nil
+}
+
+// String returns the JMESPath expression.
+func (jq *JSONQuery) String() string {
+	return jq.expression
+}
+
+// MarshalJSON implements the Marshaler interface
+func (jq *JSONQuery) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jq.expression)
+}
+
+// UnmarshalJSON implements the Unmarshaler interface
+func (jq *JSONQuery) UnmarshalJSON(b []byte) error {
+	var expression string
+	if err := json.Unmarshal(b, &expression); err != nil {
+		return err
+	}
+	query, err := NewJSONQuery(expression)
+	if err != nil {
+		return err
+	}
+	*jq = *query
+	return nil
+}
+
+// perform returns the value the query selects from the JSON object, or null
+// when it selects nothing.
+func (jq *JSONQuery) perform(val string) models.RunOutput {
+	decoder := json.NewDecoder(bytes.NewReader([]byte(val)))
+	decoder.UseNumber()
+	var data interface{}
+	if err := decoder.Decode(&data); err != nil {
+		return models.NewRunOutputError(err)
+	}
+
+	result, err := jq.compiled.Search(queryableNumbers(data))
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	return models.NewRunOutputCompleteWithResult(result)
+}
+
+// queryableNumbers converts the numbers of decoded JSON to float64, which
+// JMESPath compares and sorts, leaving those that a float64 can't represent
+// exactly as they are in the JSON.
+func queryableNumbers(data interface{}) interface{} {
+	switch v := data.(type) {
+	case json.Number:
+		if f, err := v.Float64(); err == nil && strconv.FormatFloat(f, 'f', -1, 64) == v.String() {
+			return f
+		}
+		return v
+	case []interface{}:
+		for i := range v {
+			v[i] = queryableNumbers(v[i])
+		}
+	case map[string]interface{}:
+		for k := range v {
+			v[k] = queryableNumbers(v[k])
+		}
+	}
+	return data
+}
@@ -9,6 +9,7 @@ import (
 	"chainlink/core/store/models"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestJsonParse_Perform(t *testing.T) {
@@ -131,6 +132,73 @@ func TestJsonParse_Perform(t *testing.T) {
 	}
 }
 
+func TestJsonParse_Perform_Query(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name            string
+		result          string
+		query           string
+		wantData        string
+		wantResultError bool
+	}{
+		{"key", `{"high":"11850.00","last":"11779.99"}`, "last",
+			`{"result":"11779.99"}`, false},
+		{"nonexistent key", `{"high":"11850.00","last":"11779.99"}`, "no.really",
+			`{"result":null}`, false},
+		{"select by key value", `{"data":[{"id":"a","price":1},{"id":"b","price":2}]}`, "data[?id == 'b'].price | [0]",
+			`{"result":2}`, false},
+		{"filter numbers", `{"data":[{"id":"a","price":1.5},{"id":"b","price":20}]}`, "data[?price > `10`].id",
+			`{"result":["b"]}`, false},
+		{"wildcard", `{"data":{"a":{"last":"1"},"b":{"last":"2"}}}`, "sort(data.*.last)",
+			`{"result":["1","2"]}`, false},
+		{"large integer", `{"wei":123456789012345678901234567890}`, "wei",
+			`{"result":123456789012345678901234567890}`, false},
+		{"invalid json", `{"data"`, "data",
+			``, true},
+		{"invalid function argument", `{"data":"abc"}`, "abs(data)",
+			``, true},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			query, err := adapters.NewJSONQuery(test.query)
+			require.NoError(t, err)
+			input := cltest.NewRunInputWithResult(test.result)
+			adapter := adapters.JSONParse{Query: query}
+			result := adapter.Perform(input, nil)
+
+			if test.wantResultError {
+				assert.Error(t, result.Error())
+			} else {
+				require.NoError(t, result.Error())
+				assert.Equal(t, test.wantData, result.Data().String())
+			}
+		})
+	}
+}
+
+func TestJSONParse_UnmarshalJSON_Query(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		input     string
+		wantError bool
+	}{
+		{"query", `{"query":"data[?id == 'b'] | [0].price"}`, false},
+		{"invalid query", `{"query":"data[?id =="}`, true},
+		{"query is not a string", `{"query":["data"]}`, true},
+		{"path and query", `{"path":["data"],"query":"data"}`, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a := adapters.JSONParse{}
+			err := json.Unmarshal([]byte(test.input), &a)
+			cltest.AssertError(t, test.wantError, err)
+		})
+	}
+}
+
 func TestJSON_UnmarshalJSON(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -0,0 +1,131 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"chainlink/core/logger"
+	"chainlink/core/store"
+	"chainlink/core/store/models"
+	"chainlink/core/utils"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultInitialBackoff = time.Second
+	defaultMaxBackoff     = time.Minute
+)
+
+// defaultRetryStatusCodes are the response codes retried when a RetryPolicy
+// does not list its own.
+var defaultRetryStatusCodes = []int{429, 500, 502, 503, 504}
+
+// RetryPolicy configures how an adapter retries a failed outbound request.
+// Requests that fail to connect or time out are always retried, unless the
+// node's outbound network configuration refused the connection; responses
+// are retried only if their status code is listed in StatusCodes.
+//
+// The wait between attempts starts at InitialBackoff and doubles after each
+// attempt, up to MaxBackoff.
+type RetryPolicy struct {
+	MaxAttempts    uint32          `json:"maxAttempts"`
+	InitialBackoff models.Duration `json:"initialBackoff"`
+	MaxBackoff     models.Duration `json:"maxBackoff"`
+	StatusCodes    []int           `json:"statusCodes"`
+}
+
+// HTTPResponseError is returned when an outbound request gets an unsuccessful
+// response.
+type HTTPResponseError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *HTTPResponseError) Error() string {
+	return e.Body
+}
+
+// Do calls fn until it succeeds, returns an error that should not be retried,
+// the policy runs out of attempts, or ctx is done while waiting to retry. It
+// returns the number of attempts made.
+func (rp RetryPolicy) Do(ctx context.Context, clock utils.Afterer, fn func() error) (uint32, error) {
+	var attempts uint32
+	for {
+		attempts++
+		err := fn()
+		if err == nil || attempts >= rp.MaxAttempts || !rp.retryable(err) {
+			return attempts, err
+		}
+
+		backoff := rp.backoff(attempts)
+		logger.Debugw(fmt.Sprintf("Retrying request in %v", backoff), "attempt", attempts, "error", err)
+		select {
+		case <-clock.After(backoff):
+		case <-ctx.Done():
+			return attempts, ctx.Err()
+		}
+	}
+}
+
+// Perform wraps a single outbound request with the policy, recording the
+// attempts on the returned RunOutput.
+func (rp RetryPolicy) Perform(ctx context.Context, clock utils.Afterer, fn func() (models.RunOutput, error)) models.RunOutput {
+	var output models.RunOutput
+	var lastErr error
+	attempts, err := rp.Do(ctx, clock, func() error {
+		var err error
+		output, err = fn()
+		if err != nil {
+			lastErr = err
+		}
+		return err
+	})
+	if ruleErr, ok := store.AsOutboundConnectionError(err); ok {
+		output = models.NewRunOutputError(ruleErr)
+	} else if err != nil {
+		output = models.NewRunOutputError(err)
+	}
+	return output.WithAttempts(attempts, lastErr)
+}
+
+func (rp RetryPolicy) retryable(err error) bool {
+	if _, ok := store.AsOutboundConnectionError(err); ok {
+		return false
+	}
+	switch e := errors.Cause(err).(type) {
+	case *url.Error:
+		return true
+	case *HTTPResponseError:
+		codes := rp.StatusCodes
+		if len(codes) == 0 {
+			codes = defaultRetryStatusCodes
+		}
+		for _, code := range codes {
+			if code == e.StatusCode {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (rp RetryPolicy) backoff(attempt uint32) time.Duration {
+	backoff := rp.InitialBackoff.Duration()
+	if backoff == 0 {
+		backoff = defaultInitialBackoff
+	}
+	max := rp.MaxBackoff.Duration()
+	if max == 0 {
+		max = defaultMaxBackoff
+	}
+	for i := uint32(1); i < attempt && backoff < max; i++ {
+		backoff *= 2
+	}
+	if backoff > max {
+		return max
+	}
+	return backoff
+}
@@ -0,0 +1,138 @@
+package adapters_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"chainlink/core/adapters"
+	"chainlink/core/internal/cltest"
+	"chainlink/core/store"
+	"chainlink/core/store/models"
+	"chainlink/core/store/orm"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFlakyServer responds with each of the given statuses in turn, then with
+// 200 OK for every following request.
+func newFlakyServer(statuses ...int) (*httptest.Server, *int) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls <= len(statuses) {
+			w.WriteHeader(statuses[calls-1])
+			io.WriteString(w, "flaky")
+			return
+		}
+		io.WriteString(w, `{"data": {"result": "ok"}}`)
+	}))
+	return server, &calls
+}
+
+func TestRetryPolicy_HTTPGet(t *testing.T) {
+	t.Parallel()
+
+	config := orm.NewConfig()
+	store := &store.Store{Config: config, Clock: cltest.InstantClock{}, HTTPClients: store.NewHTTPClientPool(config)}
+	tests := []struct {
+		name         string
+		retry        string
+		statuses     []int
+		wantAttempts uint32
+		wantErrored  bool
+		wantLastErr  bool
+	}{
+		{"no policy succeeds", `{}`, nil, 1, false, false},
+		{"no policy fails", `{}`, []int{503}, 1, true, true},
+		{"recovers", `{"maxAttempts": 3}`, []int{503, 500}, 3, false, true},
+		{"exhausted", `{"maxAttempts": 2}`, []int{503, 503, 503}, 2, true, true},
+		{"not retried by default", `{"maxAttempts": 3}`, []int{404}, 1, true, true},
+		{"listed status code", `{"maxAttempts": 3, "statusCodes": [404]}`, []int{404}, 2, false, true},
+		{"unlisted status code", `{"maxAttempts": 3, "statusCodes": [404]}`, []int{503}, 1, true, true},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			server, calls := newFlakyServer(test.statuses...)
+			defer server.Close()
+
+			hga := adapters.HTTPGet{URL: cltest.WebURL(t, server.URL)}
+			require.NoError(t, json.Unmarshal([]byte(test.retry), &hga.Retry))
+
+			result := hga.Perform(models.RunInput{}, store)
+			assert.Equal(t, test.wantErrored, result.HasError())
+			assert.Equal(t, test.wantAttempts, result.Attempts())
+			assert.Equal(t, int(test.wantAttempts), *calls)
+			assert.Equal(t, test.wantLastErr, result.LastError() != nil)
+		})
+	}
+}
+
+func TestRetryPolicy_OutboundRules(t *testing.T) {
+	t.Parallel()
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+	config.Set("HTTP_BLOCKED_CIDRS", "127.0.0.0/8")
+	store := &store.Store{Config: config.Config, Clock: cltest.InstantClock{}, HTTPClients: store.NewHTTPClientPool(config)}
+
+	server, calls := newFlakyServer()
+	defer server.Close()
+
+	hga := adapters.HTTPGet{URL: cltest.WebURL(t, server.URL)}
+	require.NoError(t, json.Unmarshal([]byte(`{"maxAttempts": 3}`), &hga.Retry))
+
+	result := hga.Perform(models.RunInput{}, store)
+	require.True(t, result.HasError())
+	assert.Equal(t, uint32(1), result.Attempts())
+	assert.Equal(t, 0, *calls)
+	assert.True(t, strings.HasPrefix(result.Error().Error(), "connections to 127.0.0.1"), result.Error().Error())
+}
+
+func TestRetryPolicy_Bridge(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	store.Clock = cltest.InstantClock{}
+
+	server, calls := newFlakyServer(http.StatusBadGateway)
+	defer server.Close()
+
+	_, bt := cltest.NewBridgeType(t, "flakybridge", server.URL)
+	require.NoError(t, store.CreateBridgeType(bt))
+
+	task := models.TaskSpec{
+		Type:   bt.Name,
+		Params: cltest.JSONFromString(t, `{"retry": {"maxAttempts": 2, "initialBackoff": "10ms"}, "extra": 1}`),
+	}
+	pa, err := adapters.For(task, store.Config, store.ORM)
+	require.NoError(t, err)
+
+	bridge := pa.BaseAdapter.(*adapters.Bridge)
+	assert.Equal(t, uint32(2), bridge.Retry.MaxAttempts)
+	assert.False(t, bridge.Params.Get("retry").Exists())
+
+	input := *models.NewRunInputWithResult(models.NewID(), "100", models.RunStatusUnstarted)
+	result := pa.Perform(input, store)
+	require.NoError(t, result.Error())
+	assert.Equal(t, "ok", result.Result().String())
+	assert.Equal(t, uint32(2), result.Attempts())
+	assert.Contains(t, result.LastError().Error(), "502")
+	assert.Equal(t, 2, *calls)
+}
+
+func TestRetryPolicy_InvalidBackoff(t *testing.T) {
+	t.Parallel()
+
+	var hga adapters.HTTPGet
+	assert.Error(t, json.Unmarshal([]byte(`{"retry": {"initialBackoff": "soon"}}`), &hga))
+	assert.Error(t, json.Unmarshal([]byte(`{"retry": {"maxBackoff": "-1s"}}`), &hga))
+}
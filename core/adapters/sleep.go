@@ -14,12 +14,13 @@ type Sleep struct {
 	Until models.AnyTime `json:"until"`
 }
 
-// Perform returns the input RunResult after waiting for the specified Until parameter.
+// Perform parks the task in pending_sleep until the Until time, when the
+// node resumes the run. The task completes straight away once that time has
+// passed.
 func (adapter *Sleep) Perform(input models.RunInput, str *store.Store) models.RunOutput {
-	duration := adapter.Duration()
-	if duration > 0 {
-		logger.Debugw("Task sleeping...", "duration", duration)
-		<-str.Clock.After(duration)
+	if adapter.Until.Time.After(str.Clock.Now()) {
+		logger.Debugw("Task sleeping...", "until", adapter.Until.Time)
+		return models.NewRunOutputPendingSleep(adapter.Until.Time)
 	}
 
 	return models.NewRunOutputComplete(models.JSON{})
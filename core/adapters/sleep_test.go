@@ -15,30 +15,15 @@ import (
 func TestSleep_Perform(t *testing.T) {
 	store, cleanup := cltest.NewStore(t)
 	defer cleanup()
-	clock := cltest.NewTriggerClock(t)
-	store.Clock = clock
 
 	adapter := adapters.Sleep{}
 	err := json.Unmarshal([]byte(`{"until": 2147483647}`), &adapter)
 	assert.NoError(t, err)
 
-	doneChan := make(chan struct{})
-	go func() {
-		result := adapter.Perform(models.RunInput{}, store)
-		assert.Equal(t, string(models.RunStatusCompleted), string(result.Status()))
-		doneChan <- struct{}{}
-	}()
-
-	select {
-	case <-doneChan:
-		t.Error("Sleep adapter did not sleep")
-	default:
-	}
-
-	clock.Trigger()
-
-	_, ok := <-doneChan
-	assert.True(t, ok)
+	result := adapter.Perform(models.RunInput{}, store)
+	require.NoError(t, result.Error())
+	assert.Equal(t, string(models.RunStatusPendingSleep), string(result.Status()))
+	assert.Equal(t, int64(2147483647), result.WakeAt().Unix())
 }
 
 func TestSleep_Perform_AlreadyElapsed(t *testing.T) {
@@ -0,0 +1,110 @@
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"chainlink/core/store"
+	"chainlink/core/store/models"
+)
+
+// Transform evaluates an arithmetic or string expression over the run's data,
+// and sets the result to its value.
+type Transform struct {
+	Expression *TransformExpression `json:"expression"`
+}
+
+// Perform returns the value of the adapter's expression, evaluated with the
+// run's data.
+//
+// For example, with the input {"result": "1234500000000000000000"} the
+// expression "round(result / pow(10, 18), 2)" results in "1234.5".
+func (t *Transform) Perform(input models.RunInput, store *store.Store) models.RunOutput {
+	return t.PerformContext(context.Background(), input, store)
+}
+
+// PerformContext is Perform, abandoning the evaluation when ctx is done.
+func (t *Transform) PerformContext(ctx context.Context, input models.RunInput, _ *store.Store) models.RunOutput {
+	if t.Expression == nil {
+		return models.NewRunOutputError(errors.New("transform requires an expression"))
+	}
+	result, err := t.Expression.Evaluate(ctx, input.Data())
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	return models.NewRunOutputCompleteWithResult(result)
+}
+
+// TransformExpression is a compiled Transform expression.
+//
+// Expressions are made of numbers, quoted strings, the operators +, -, * and
+// /, parentheses, function calls and references to the run's data. A
+// reference is a path into the data, such as "result" or "data.prices.0",
+// whose value must be a number, or a string, which is read as a number when
+// it holds one.
+//
+// Numbers are exact fractions, so that no precision is lost, of up to 4096
+// bits in their numerator and denominator. The result is written as a
+// decimal, rounded to 18 decimal places when it has more.
+//
+// The functions are:
+//  pow(x, n)                x to the power of the integer n
+//  min(x, ...), max(x, ...) the smallest or largest of their arguments
+//  abs(x)                   the absolute value of x
+//  round(x[, places[, mode]]) x rounded to places decimals, 0 by default,
+//                           with the mode "halfUp" (the default), "halfDown",
+//                           "halfEven", "up", "down", "ceiling" or "floor"
+//  floor, ceil, trunc(x[, places]) x rounded down, up or towards zero
+//  concat(x, ...)           the concatenation of their arguments as strings
+//  format(f, x, ...)        the arguments formatted as by fmt.Sprintf
+//  hex(x)                   the integer x as a 0x prefixed hex string
+//  fromhex(s)               the number in the hex string s
+type TransformExpression struct {
+	source string
+	root   expressionNode
+}
+
+// NewTransformExpression compiles the expression.
+func NewTransformExpression(source string) (*TransformExpression, error) {
+	root, err := parseExpression(source)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transform expression %q: %v", source, err)
+	}
+	return &TransformExpression{source: source, root: root}, nil
+}
+
+// String returns the source of the expression.
+func (te *TransformExpression) String() string {
+	return te.source
+}
+
+// MarshalJSON implements the Marshaler interface
+func (te *TransformExpression) MarshalJSON() ([]byte, error) {
+	return json.Marshal(te.source)
+}
+
+// UnmarshalJSON implements the Unmarshaler interface
+func (te *TransformExpression) UnmarshalJSON(b []byte) error {
+	var source string
+	if err := json.Unmarshal(b, &source); err != nil {
+		return err
+	}
+	expression, err := NewTransformExpression(source)
+	if err != nil {
+		return err
+	}
+	*te = *expression
+	return nil
+}
+
+// Evaluate returns the value of the expression with the given data, as a
+// string. Evaluation stops with ctx's error once ctx is done.
+func (te *TransformExpression) Evaluate(ctx context.Context, data models.JSON) (string, error) {
+	value, err := evalNode(ctx, te.root, data)
+	if err != nil {
+		return "", err
+	}
+	return valueString(value), nil
+}
@@ -0,0 +1,667 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"chainlink/core/store/models"
+
+	"github.com/tidwall/gjson"
+)
+
+const (
+	// transformDecimals is the number of decimal places a result is rounded
+	// to when it has more, such as the result of 1 / 3.
+	transformDecimals = 18
+	// maxTransformExponent bounds pow, so that an expression can't build
+	// numbers too large to compute.
+	maxTransformExponent = 1024
+	// maxTransformBits bounds the numerator and denominator of every number
+	// read or computed, so that each operation stays cheap however the
+	// expression nests them.
+	maxTransformBits = 4096
+	// maxTransformPlaces bounds the decimal places numbers are rounded to.
+	maxTransformPlaces = 100
+)
+
+// decimalPattern matches the strings read as numbers. The exponent is kept
+// short for the same reason as maxTransformExponent.
+var decimalPattern = regexp.MustCompile(`^[-+]?(\d+\.?\d*|\.\d+)([eE][-+]?\d{1,3})?$`)
+
+// A transformValue is either a *big.Rat or a string.
+type transformValue interface{}
+
+type expressionNode interface {
+	eval(ctx context.Context, data models.JSON) (transformValue, error)
+}
+
+// evalNode evaluates the node, failing once ctx is done, or when the node's
+// value is a number of more than maxTransformBits.
+func evalNode(ctx context.Context, node expressionNode, data models.JSON) (transformValue, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	v, err := node.eval(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	if r, ok := v.(*big.Rat); ok && ratBits(r) > maxTransformBits {
+		return nil, fmt.Errorf("number is larger than %d bits", maxTransformBits)
+	}
+	return v, nil
+}
+
+type literalNode struct {
+	value transformValue
+}
+
+func (n literalNode) eval(context.Context, models.JSON) (transformValue, error) {
+	return n.value, nil
+}
+
+type referenceNode struct {
+	path string
+}
+
+func (n referenceNode) eval(_ context.Context, data models.JSON) (transformValue, error) {
+	result := data.Get(n.path)
+	switch result.Type {
+	case gjson.Number:
+		if r, ok := parseDecimal(result.Raw); ok {
+			return r, nil
+		}
+		return nil, fmt.Errorf("%s is not a valid number: %s", n.path, result.Raw)
+	case gjson.String:
+		if r, ok := parseDecimal(result.Str); ok {
+			return r, nil
+		}
+		return result.Str, nil
+	case gjson.Null:
+		return nil, fmt.Errorf("no value at %s", n.path)
+	default:
+		return nil, fmt.Errorf("%s is not a number or string", n.path)
+	}
+}
+
+type negateNode struct {
+	operand expressionNode
+}
+
+func (n negateNode) eval(ctx context.Context, data models.JSON) (transformValue, error) {
+	x, err := evalNumber(ctx, n.operand, data, "-")
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Rat).Neg(x), nil
+}
+
+type binaryNode struct {
+	operator    byte
+	left, right expressionNode
+}
+
+// eval applies the operator to operands of at most maxTransformBits each, so
+// that even a product is cheap to compute before its own size is checked.
+func (n binaryNode) eval(ctx context.Context, data models.JSON) (transformValue, error) {
+	operator := string(n.operator)
+	x, err := evalNumber(ctx, n.left, data, operator)
+	if err != nil {
+		return nil, err
+	}
+	y, err := evalNumber(ctx, n.right, data, operator)
+	if err != nil {
+		return nil, err
+	}
+	switch n.operator {
+	case '+':
+		return new(big.Rat).Add(x, y), nil
+	case '-':
+		return new(big.Rat).Sub(x, y), nil
+	case '*':
+		return new(big.Rat).Mul(x, y), nil
+	default:
+		if y.Sign() == 0 {
+			return nil, errors.New("division by zero")
+		}
+		return new(big.Rat).Quo(x, y), nil
+	}
+}
+
+type callNode struct {
+	function transformFunction
+	args     []expressionNode
+}
+
+func (n callNode) eval(ctx context.Context, data models.JSON) (transformValue, error) {
+	args := make([]transformValue, len(n.args))
+	for i, arg := range n.args {
+		v, err := evalNode(ctx, arg, data)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = v
+	}
+	v, err := n.function.call(args)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", n.function.name, err)
+	}
+	return v, nil
+}
+
+func evalNumber(ctx context.Context, node expressionNode, data models.JSON, operator string) (*big.Rat, error) {
+	v, err := evalNode(ctx, node, data)
+	if err != nil {
+		return nil, err
+	}
+	if r, ok := v.(*big.Rat); ok {
+		return r, nil
+	}
+	return nil, fmt.Errorf("%s requires numbers, got the string %q", operator, v)
+}
+
+type transformFunction struct {
+	name             string
+	minArgs, maxArgs int // maxArgs is -1 when there's no limit
+	call             func(args []transformValue) (transformValue, error)
+}
+
+var transformFunctions = map[string]transformFunction{}
+
+func init() {
+	for _, f := range []transformFunction{
+		{"pow", 2, 2, transformPow},
+		{"min", 1, -1, func(args []transformValue) (transformValue, error) { return extreme(args, -1) }},
+		{"max", 1, -1, func(args []transformValue) (transformValue, error) { return extreme(args, 1) }},
+		{"abs", 1, 1, func(args []transformValue) (transformValue, error) {
+			x, err := numberArg(args, 0)
+			if err != nil {
+				return nil, err
+			}
+			return new(big.Rat).Abs(x), nil
+		}},
+		{"round", 1, 3, func(args []transformValue) (transformValue, error) {
+			mode := roundHalfUp
+			if len(args) == 3 {
+				s, ok := args[2].(string)
+				if !ok {
+					return nil, errors.New("rounding mode must be a string")
+				}
+				mode = roundingMode(s)
+			}
+			return roundArgs(args[:minInt(len(args), 2)], mode)
+		}},
+		{"floor", 1, 2, func(args []transformValue) (transformValue, error) { return roundArgs(args, roundFloor) }},
+		{"ceil", 1, 2, func(args []transformValue) (transformValue, error) { return roundArgs(args, roundCeiling) }},
+		{"trunc", 1, 2, func(args []transformValue) (transformValue, error) { return roundArgs(args, roundDown) }},
+		{"concat", 1, -1, func(args []transformValue) (transformValue, error) {
+			var b strings.Builder
+			for _, arg := range args {
+				b.WriteString(valueString(arg))
+			}
+			return b.String(), nil
+		}},
+		{"format", 1, -1, transformFormat},
+		{"hex", 1, 1, transformHex},
+		{"fromhex", 1, 1, transformFromHex},
+	} {
+		transformFunctions[f.name] = f
+	}
+}
+
+func numberArg(args []transformValue, i int) (*big.Rat, error) {
+	r, ok := args[i].(*big.Rat)
+	if !ok {
+		return nil, fmt.Errorf("argument %d must be a number, got the string %q", i+1, args[i])
+	}
+	return r, nil
+}
+
+func integerArg(args []transformValue, i int) (int64, error) {
+	r, err := numberArg(args, i)
+	if err != nil {
+		return 0, err
+	}
+	if !r.IsInt() || !r.Num().IsInt64() {
+		return 0, fmt.Errorf("argument %d must be an integer, got %s", i+1, valueString(r))
+	}
+	return r.Num().Int64(), nil
+}
+
+func transformPow(args []transformValue) (transformValue, error) {
+	x, err := numberArg(args, 0)
+	if err != nil {
+		return nil, err
+	}
+	n, err := integerArg(args, 1)
+	if err != nil {
+		return nil, err
+	}
+	if n > maxTransformExponent || n < -maxTransformExponent {
+		return nil, fmt.Errorf("exponent must be between %d and %d", -maxTransformExponent, maxTransformExponent)
+	}
+	if n < 0 {
+		if x.Sign() == 0 {
+			return nil, errors.New("division by zero")
+		}
+		x = new(big.Rat).Inv(x)
+		n = -n
+	}
+	// x to the power of n has at most n times as many bits as x.
+	if int64(ratBits(x))*n > maxTransformBits {
+		return nil, fmt.Errorf("result would be larger than %d bits", maxTransformBits)
+	}
+	exp := big.NewInt(n)
+	num := new(big.Int).Exp(x.Num(), exp, nil)
+	denom := new(big.Int).Exp(x.Denom(), exp, nil)
+	return new(big.Rat).SetFrac(num, denom), nil
+}
+
+// extreme returns the smallest of the numbers when sign is -1, and the
+// largest when it is 1.
+func extreme(args []transformValue, sign int) (transformValue, error) {
+	var result *big.Rat
+	for i := range args {
+		x, err := numberArg(args, i)
+		if err != nil {
+			return nil, err
+		}
+		if result == nil || x.Cmp(result) == sign {
+			result = x
+		}
+	}
+	return result, nil
+}
+
+type roundingMode string
+
+const (
+	roundHalfUp   roundingMode = "halfUp"
+	roundHalfDown roundingMode = "halfDown"
+	roundHalfEven roundingMode = "halfEven"
+	roundUp       roundingMode = "up"
+	roundDown     roundingMode = "down"
+	roundCeiling  roundingMode = "ceiling"
+	roundFloor    roundingMode = "floor"
+)
+
+func roundArgs(args []transformValue, mode roundingMode) (transformValue, error) {
+	x, err := numberArg(args, 0)
+	if err != nil {
+		return nil, err
+	}
+	var places int64
+	if len(args) > 1 {
+		if places, err = integerArg(args, 1); err != nil {
+			return nil, err
+		}
+		if places < 0 || places > maxTransformPlaces {
+			return nil, fmt.Errorf("decimal places must be between 0 and %d", maxTransformPlaces)
+		}
+	}
+	return roundRat(x, int(places), mode)
+}
+
+// roundRat returns x rounded to the given number of decimal places.
+func roundRat(x *big.Rat, places int, mode roundingMode) (*big.Rat, error) {
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(places)), nil)
+	scaled := new(big.Rat).Mul(x, new(big.Rat).SetInt(scale))
+
+	quo, rem := new(big.Int).QuoRem(scaled.Num(), scaled.Denom(), new(big.Int))
+	// half compares the discarded fraction to one half.
+	half := new(big.Int).Mul(new(big.Int).Abs(rem), big.NewInt(2)).Cmp(scaled.Denom())
+
+	var awayFromZero bool
+	switch mode {
+	case roundHalfUp:
+		awayFromZero = half >= 0
+	case roundHalfDown:
+		awayFromZero = half > 0
+	case roundHalfEven:
+		awayFromZero = half > 0 || (half == 0 && quo.Bit(0) == 1)
+	case roundUp:
+		awayFromZero = rem.Sign() != 0
+	case roundDown:
+		awayFromZero = false
+	case roundCeiling:
+		awayFromZero = rem.Sign() > 0
+	case roundFloor:
+		awayFromZero = rem.Sign() < 0
+	default:
+		return nil, fmt.Errorf("unknown rounding mode %q", mode)
+	}
+	if awayFromZero {
+		quo.Add(quo, big.NewInt(int64(scaled.Sign())))
+	}
+	return new(big.Rat).SetFrac(quo, scale), nil
+}
+
+func transformFormat(args []transformValue) (transformValue, error) {
+	f, ok := args[0].(string)
+	if !ok {
+		return nil, errors.New("the format must be a string")
+	}
+	formatArgs := make([]interface{}, len(args)-1)
+	for i, arg := range args[1:] {
+		if r, ok := arg.(*big.Rat); ok {
+			formatArgs[i] = formattableNumber{r}
+		} else {
+			formatArgs[i] = arg
+		}
+	}
+	return fmt.Sprintf(f, formatArgs...), nil
+}
+
+// formattableNumber formats integers with the integer verbs, such as %d and
+// %x, as a big.Int, and numbers with the floating point verbs, such as %.2f,
+// as a big.Float.
+type formattableNumber struct {
+	r *big.Rat
+}
+
+func (n formattableNumber) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 'd', 'x', 'X', 'o', 'b':
+		if n.r.IsInt() {
+			n.r.Num().Format(s, verb)
+			return
+		}
+	case 'e', 'E', 'f', 'F', 'g', 'G':
+		f := new(big.Float).SetPrec(256).SetRat(n.r)
+		f.Format(s, verb)
+		return
+	}
+	fmt.Fprint(s, valueString(n.r))
+}
+
+func transformHex(args []transformValue) (transformValue, error) {
+	x, err := numberArg(args, 0)
+	if err != nil {
+		return nil, err
+	}
+	if !x.IsInt() {
+		return nil, fmt.Errorf("%s is not an integer", valueString(x))
+	}
+	if x.Sign() < 0 {
+		return "-0x" + new(big.Int).Neg(x.Num()).Text(16), nil
+	}
+	return "0x" + x.Num().Text(16), nil
+}
+
+func transformFromHex(args []transformValue) (transformValue, error) {
+	s := valueString(args[0])
+	digits := strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	i, ok := new(big.Int).SetString(digits, 16)
+	if !ok || strings.HasPrefix(digits, "-") || strings.HasPrefix(digits, "+") {
+		return nil, fmt.Errorf("%q is not a hex number", s)
+	}
+	return new(big.Rat).SetInt(i), nil
+}
+
+func parseDecimal(s string) (*big.Rat, bool) {
+	if !decimalPattern.MatchString(s) {
+		return nil, false
+	}
+	return new(big.Rat).SetString(s)
+}
+
+// valueString returns the string, or the number as a decimal rounded to
+// transformDecimals places.
+func valueString(v transformValue) string {
+	r, ok := v.(*big.Rat)
+	if !ok {
+		return fmt.Sprint(v)
+	}
+	return decimalString(r, transformDecimals)
+}
+
+// ratBits returns the bit length of the larger of r's numerator and
+// denominator.
+func ratBits(r *big.Rat) int {
+	num, denom := r.Num().BitLen(), r.Denom().BitLen()
+	if num > denom {
+		return num
+	}
+	return denom
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// parseExpression compiles the source with a recursive descent parser of
+// the grammar:
+//  expression = term {("+" | "-") term}
+//  term       = unary {("*" | "/") unary}
+//  unary      = "-" unary | primary
+//  primary    = number | string | call | reference | "(" expression ")"
+//  call       = identifier "(" [expression {"," expression}] ")"
+func parseExpression(source string) (expressionNode, error) {
+	tokens, err := lexExpression(source)
+	if err != nil {
+		return nil, err
+	}
+	p := &expressionParser{tokens: tokens}
+	node, err := p.expression()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokenEnd {
+		return nil, fmt.Errorf("unexpected %s at position %d", t, t.pos)
+	}
+	return node, nil
+}
+
+type tokenKind int
+
+const (
+	tokenEnd tokenKind = iota
+	tokenNumber
+	tokenString
+	tokenIdentifier
+	tokenSymbol
+)
+
+type expressionToken struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func (t expressionToken) String() string {
+	if t.kind == tokenEnd {
+		return "end of expression"
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+func lexExpression(source string) ([]expressionToken, error) {
+	var tokens []expressionToken
+	runes := []rune(source)
+	for i := 0; i < len(runes); {
+		c := runes[i]
+		start := i
+		switch {
+		case unicode.IsSpace(c):
+			i++
+			continue
+		case strings.ContainsRune("+-*/(),", c):
+			i++
+			tokens = append(tokens, expressionToken{tokenSymbol, string(c), start})
+		case c == '"' || c == '\'':
+			var b strings.Builder
+			for i++; i < len(runes) && runes[i] != c; i++ {
+				if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+				}
+				b.WriteRune(runes[i])
+			}
+			if i == len(runes) {
+				return nil, fmt.Errorf("unterminated string at position %d", start)
+			}
+			i++
+			tokens = append(tokens, expressionToken{tokenString, b.String(), start})
+		case unicode.IsDigit(c) || c == '.':
+			isHex := len(runes) > i+1 && c == '0' && (runes[i+1] == 'x' || runes[i+1] == 'X')
+			for i < len(runes) && (isIdentifierRune(runes[i]) ||
+				(!isHex && (runes[i] == '+' || runes[i] == '-') && (runes[i-1] == 'e' || runes[i-1] == 'E'))) {
+				i++
+			}
+			tokens = append(tokens, expressionToken{tokenNumber, string(runes[start:i]), start})
+		case c == '_' || c == '$' || unicode.IsLetter(c):
+			for i < len(runes) && (isIdentifierRune(runes[i]) || runes[i] == '$') {
+				i++
+			}
+			tokens = append(tokens, expressionToken{tokenIdentifier, string(runes[start:i]), start})
+		default:
+			return nil, fmt.Errorf("unexpected %q at position %d", c, start)
+		}
+	}
+	return append(tokens, expressionToken{tokenEnd, "", len(runes)}), nil
+}
+
+func isIdentifierRune(c rune) bool {
+	return c == '_' || c == '.' || unicode.IsLetter(c) || unicode.IsDigit(c)
+}
+
+type expressionParser struct {
+	tokens []expressionToken
+	next   int
+}
+
+func (p *expressionParser) peek() expressionToken {
+	return p.tokens[p.next]
+}
+
+func (p *expressionParser) advance() expressionToken {
+	t := p.tokens[p.next]
+	if t.kind != tokenEnd {
+		p.next++
+	}
+	return t
+}
+
+func (p *expressionParser) acceptSymbol(symbols string) (byte, bool) {
+	t := p.peek()
+	if t.kind == tokenSymbol && strings.Contains(symbols, t.text) {
+		p.advance()
+		return t.text[0], true
+	}
+	return 0, false
+}
+
+func (p *expressionParser) expectSymbol(symbol string) error {
+	if _, ok := p.acceptSymbol(symbol); !ok {
+		t := p.peek()
+		return fmt.Errorf("expected %q at position %d, got %s", symbol, t.pos, t)
+	}
+	return nil
+}
+
+func (p *expressionParser) expression() (expressionNode, error) {
+	return p.binary("+-", p.term)
+}
+
+func (p *expressionParser) term() (expressionNode, error) {
+	return p.binary("*/", p.unary)
+}
+
+func (p *expressionParser) binary(operators string, operand func() (expressionNode, error)) (expressionNode, error) {
+	left, err := operand()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		operator, ok := p.acceptSymbol(operators)
+		if !ok {
+			return left, nil
+		}
+		right, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		left = binaryNode{operator: operator, left: left, right: right}
+	}
+}
+
+func (p *expressionParser) unary() (expressionNode, error) {
+	if _, ok := p.acceptSymbol("-"); ok {
+		operand, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return negateNode{operand}, nil
+	}
+	return p.primary()
+}
+
+func (p *expressionParser) primary() (expressionNode, error) {
+	t := p.advance()
+	switch t.kind {
+	case tokenNumber:
+		if strings.HasPrefix(t.text, "0x") || strings.HasPrefix(t.text, "0X") {
+			v, err := transformFromHex([]transformValue{t.text})
+			if err != nil {
+				return nil, fmt.Errorf("invalid number %q at position %d", t.text, t.pos)
+			}
+			return literalNode{v}, nil
+		}
+		r, ok := parseDecimal(t.text)
+		if !ok {
+			return nil, fmt.Errorf("invalid number %q at position %d", t.text, t.pos)
+		}
+		return literalNode{r}, nil
+	case tokenString:
+		return literalNode{t.text}, nil
+	case tokenIdentifier:
+		if _, ok := p.acceptSymbol("("); ok {
+			return p.call(t)
+		}
+		return referenceNode{t.text}, nil
+	case tokenSymbol:
+		if t.text == "(" {
+			node, err := p.expression()
+			if err != nil {
+				return nil, err
+			}
+			return node, p.expectSymbol(")")
+		}
+	}
+	return nil, fmt.Errorf("unexpected %s at position %d", t, t.pos)
+}
+
+func (p *expressionParser) call(name expressionToken) (expressionNode, error) {
+	function, ok := transformFunctions[name.text]
+	if !ok {
+		return nil, fmt.Errorf("unknown function %q at position %d", name.text, name.pos)
+	}
+
+	var args []expressionNode
+	if _, ok := p.acceptSymbol(")"); !ok {
+		for {
+			arg, err := p.expression()
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+			if _, ok := p.acceptSymbol(","); !ok {
+				break
+			}
+		}
+		if err := p.expectSymbol(")"); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(args) < function.minArgs || (function.maxArgs >= 0 && len(args) > function.maxArgs) {
+		return nil, fmt.Errorf("wrong number of arguments to %s at position %d", name.text, name.pos)
+	}
+	return callNode{function: function, args: args}, nil
+}
@@ -0,0 +1,149 @@
+package adapters_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"chainlink/core/adapters"
+	"chainlink/core/internal/cltest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransform_Perform(t *testing.T) {
+	input := `{
+		"result": "1234567890000000000000",
+		"price": 3.14159,
+		"base": "1000000000000000000000",
+		"prices": [10, "20", 30],
+		"symbol": "ETH",
+		"hex": "0x1f",
+		"flag": true
+	}`
+
+	tests := []struct {
+		name       string
+		expression string
+		want       string
+		errored    bool
+	}{
+		{"subtract", "result - base", "234567890000000000000", false},
+		{"decimals factor", "result / pow(10, 18)", "1234.56789", false},
+		{"precedence", "1 + 2 * 3 - 4 / 2", "5", false},
+		{"parentheses", "(1 + 2) * 3", "9", false},
+		{"unary minus", "-price * -2", "6.28318", false},
+		{"exact decimals", "0.1 + 0.2", "0.3", false},
+		{"repeating decimal", "1 / 3", "0.333333333333333333", false},
+		{"negative exponent", "pow(2, -2)", "0.25", false},
+		{"big numbers", "pow(2, 256) - 1", "115792089237316195423570985008687907853269984665640564039457584007913129639935", false},
+		{"largest pow", "pow(10, 1024) / pow(10, 1023)", "10", false},
+		{"scientific notation", "1.5e3 + 1", "1501", false},
+		{"array index", "prices.0 + prices.1 + prices.2", "60", false},
+		{"min", "min(prices.0, prices.1, price)", "3.14159", false},
+		{"max", "max(prices.0, prices.1, price)", "20", false},
+		{"abs", "abs(1 - price)", "2.14159", false},
+		{"round", "round(price, 2)", "3.14", false},
+		{"round half up", "round(2.5)", "3", false},
+		{"round negative half up", "round(-2.5)", "-3", false},
+		{"round half even", "round(2.5, 0, 'halfEven')", "2", false},
+		{"round half down", "round(2.55, 1, 'halfDown')", "2.5", false},
+		{"round up", "round(2.01, 1, 'up')", "2.1", false},
+		{"round ceiling negative", "round(-2.09, 1, 'ceiling')", "-2", false},
+		{"floor", "floor(price, 3)", "3.141", false},
+		{"floor negative", "floor(-price)", "-4", false},
+		{"ceil", "ceil(price)", "4", false},
+		{"trunc negative", "trunc(-price, 1)", "-3.1", false},
+		{"concat", `concat(symbol, "/", 'USD ', round(price, 2))`, "ETH/USD 3.14", false},
+		{"format float", "format('%s costs %.3f', symbol, price)", "ETH costs 3.142", false},
+		{"format integer", "format('%d %x %v', 255, 255, price)", "255 ff 3.14159", false},
+		{"hex", "hex(255)", "0xff", false},
+		{"hex negative", "hex(-255)", "-0xff", false},
+		{"hex literal", "0x10 + 1", "17", false},
+		{"fromhex", "fromhex(hex) * 2", "62", false},
+		{"escaped quote", `concat('it\'s')`, "it's", false},
+
+		{"division by zero", "1 / (price - price)", "", true},
+		{"missing path", "nothere + 1", "", true},
+		{"boolean", "flag + 1", "", true},
+		{"arithmetic on string", "symbol * 2", "", true},
+		{"hex of fraction", "hex(price)", "", true},
+		{"fractional exponent", "pow(2, 0.5)", "", true},
+		{"exponent too large", "pow(10, 100000)", "", true},
+		{"nested pow too large", "pow(pow(10, 1000), 1000)", "", true},
+		{"product too large", "pow(10, 1000) * pow(10, 1000)", "", true},
+		{"unknown rounding mode", "round(price, 2, 'sideways')", "", true},
+		{"invalid hex", "fromhex(symbol)", "", true},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			adapter := adapters.Transform{}
+			params, err := json.Marshal(map[string]string{"expression": test.expression})
+			require.NoError(t, err)
+			require.NoError(t, json.Unmarshal(params, &adapter))
+
+			result := adapter.Perform(cltest.NewRunInputWithString(t, input), nil)
+			if test.errored {
+				assert.Error(t, result.Error())
+			} else {
+				require.NoError(t, result.Error())
+				assert.Equal(t, test.want, result.Result().String())
+			}
+		})
+	}
+}
+
+func TestTransform_PerformContext_Cancelled(t *testing.T) {
+	adapter := adapters.Transform{}
+	require.NoError(t, json.Unmarshal([]byte(`{"expression":"pow(2, 10) + 1"}`), &adapter))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	result := adapter.PerformContext(ctx, cltest.NewRunInputWithString(t, `{}`), nil)
+	assert.Equal(t, context.Canceled, result.Error())
+}
+
+func TestTransform_InvalidExpression(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+	}{
+		{"empty", ""},
+		{"dangling operator", "1 +"},
+		{"unbalanced parentheses", "(1 + 2"},
+		{"trailing tokens", "1 2"},
+		{"unknown function", "sqrt(2)"},
+		{"too few arguments", "pow(2)"},
+		{"too many arguments", "abs(1, 2)"},
+		{"unterminated string", "concat('abc)"},
+		{"invalid number", "1.2.3"},
+		{"unexpected character", "1 % 2"},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := adapters.NewTransformExpression(test.expression)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestTransformExpression_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	adapter := adapters.Transform{}
+	params := `{"expression":"round(result / pow(10, 18), 2)"}`
+	require.NoError(t, json.Unmarshal([]byte(params), &adapter))
+
+	b, err := json.Marshal(adapter)
+	require.NoError(t, err)
+	assert.JSONEq(t, params, string(b))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"expression":"round("}`), &adapter))
+}
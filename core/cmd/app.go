@@ -31,6 +31,21 @@ func NewApp(client *Client) *cli.App {
 			Name:  "admin",
 			Usage: "Commands for remotely taking admin related actions",
 			Subcommands: []cli.Command{
+				{
+					Name:   "audit",
+					Usage:  "List the administrative actions taken on the node, newest first",
+					Action: client.IndexAuditLog,
+					Flags: []cli.Flag{
+						cli.IntFlag{
+							Name:  "page",
+							Usage: "page of the audit log to display",
+						},
+						cli.StringFlag{
+							Name:  "action",
+							Usage: "only display entries for this action, such as job.created",
+						},
+					},
+				},
 				{
 					Name:   "chpass",
 					Usage:  "Change your account password remotely",
@@ -137,6 +152,22 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "Create Job from a Job Specification JSON",
 					Action: client.CreateJobSpec,
 				},
+				{
+					Name:   "export",
+					Usage:  "Export Jobs, or all Jobs when none are given, with the Bridges, External Initiators and Service Agreements they depend on",
+					Action: client.ExportJobBundle,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "output, o",
+							Usage: "file to write the bundle to, instead of stdout",
+						},
+					},
+				},
+				{
+					Name:   "import",
+					Usage:  "Import a bundle of Jobs exported from another node",
+					Action: client.ImportJobBundle,
+				},
 				{
 					Name:   "list",
 					Usage:  "List all jobs",
@@ -153,6 +184,11 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "Show a specific Job's details",
 					Action: client.ShowJobSpec,
 				},
+				{
+					Name:   "simulate",
+					Usage:  "Run the tasks of a Job Specification JSON with optional input JSON, without creating the Job",
+					Action: client.SimulateJobSpec,
+				},
 			},
 		},
 
@@ -226,6 +262,46 @@ func NewApp(client *Client) *cli.App {
 							Name:  "jobid",
 							Usage: "filter all Runs to match the given jobid",
 						},
+						cli.StringSliceFlag{
+							Name:  "status",
+							Usage: "only list Runs with the given status, which can be given more than once",
+						},
+						cli.StringFlag{
+							Name:  "initiator-type",
+							Usage: "only list Runs started by an initiator of the given type",
+						},
+						cli.StringFlag{
+							Name:  "created-after",
+							Usage: "only list Runs created at or after the given RFC3339 time",
+						},
+						cli.StringFlag{
+							Name:  "created-before",
+							Usage: "only list Runs created before the given RFC3339 time",
+						},
+						cli.StringFlag{
+							Name:  "finished-after",
+							Usage: "only list Runs finished at or after the given RFC3339 time",
+						},
+						cli.StringFlag{
+							Name:  "finished-before",
+							Usage: "only list Runs finished before the given RFC3339 time",
+						},
+						cli.StringFlag{
+							Name:  "requester",
+							Usage: "only list Runs requested by the given address",
+						},
+						cli.StringFlag{
+							Name:  "request-id",
+							Usage: "only list Runs for the given request ID",
+						},
+						cli.StringFlag{
+							Name:  "tx-hash",
+							Usage: "only list Runs requested in the given transaction",
+						},
+						cli.StringFlag{
+							Name:  "error",
+							Usage: "only list Runs whose error contains the given text",
+						},
 					},
 				},
 				{
@@ -128,14 +128,35 @@ func (cli *Client) ShowJobRun(c *clipkg.Context) error {
 	return cli.renderAPIResponse(resp, &job)
 }
 
-// IndexJobRuns returns the list of all job runs for a specific job
-// if no jobid is passed, defaults to returning all jobruns
+// IndexJobRuns returns the list of all job runs matching the filter flags,
+// such as jobid or status, defaulting to all job runs when none are passed.
 func (cli *Client) IndexJobRuns(c *clipkg.Context) error {
-	jobID := c.String("jobid")
-	if jobID != "" {
-		return cli.getPage("/v2/runs?jobSpecId="+jobID, c.Int("page"), &[]presenters.JobRun{})
+	q := url.Values{}
+	for flag, param := range map[string]string{
+		"jobid":           "jobSpecId",
+		"initiator-type":  "initiatorType",
+		"created-after":   "createdAfter",
+		"created-before":  "createdBefore",
+		"finished-after":  "finishedAfter",
+		"finished-before": "finishedBefore",
+		"requester":       "requester",
+		"request-id":      "requestId",
+		"tx-hash":         "txHash",
+		"error":           "error",
+	} {
+		if value := c.String(flag); value != "" {
+			q.Set(param, value)
+		}
+	}
+	for _, status := range c.StringSlice("status") {
+		q.Add("status", status)
+	}
+
+	requestURI := "/v2/runs"
+	if len(q) > 0 {
+		requestURI += "?" + q.Encode()
 	}
-	return cli.getPage("/v2/runs", c.Int("page"), &[]presenters.JobRun{})
+	return cli.getPage(requestURI, c.Int("page"), &[]presenters.JobRun{})
 }
 
 // ShowJobSpec returns the status of the given JobID.
@@ -178,6 +199,95 @@ func (cli *Client) CreateJobSpec(c *clipkg.Context) error {
 	return cli.renderAPIResponse(resp, &js)
 }
 
+// SimulateJobSpec runs the tasks of a JobSpec with optional JSON input,
+// without creating the job
+func (cli *Client) SimulateJobSpec(c *clipkg.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass in JSON or filepath [input JSON blob | input JSON filepath]"))
+	}
+
+	specBuf, err := getBufferFromJSON(c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	request := map[string]json.RawMessage{}
+	if err = json.Unmarshal(specBuf.Bytes(), &request); err != nil {
+		return cli.errorOut(err)
+	}
+	if c.NArg() > 1 {
+		inputBuf, err := getBufferFromJSON(c.Args().Get(1))
+		if err != nil {
+			return cli.errorOut(err)
+		}
+		request["input"] = inputBuf.Bytes()
+	}
+
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	resp, err := cli.HTTP.Post("/v2/specs/simulate", bytes.NewBuffer(requestData))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+
+	var simulation web.JobSimulation
+	return cli.renderAPIResponse(resp, &simulation)
+}
+
+// ExportJobBundle writes the given jobs, or every job when none are given,
+// with the bridges, external initiators and service agreements they depend
+// on, as a bundle to import into another node
+func (cli *Client) ExportJobBundle(c *clipkg.Context) error {
+	query := url.Values{}
+	for _, id := range c.Args() {
+		query.Add("jobSpecId", id)
+	}
+	resp, err := cli.HTTP.Get("/v2/job_bundles?" + query.Encode())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+
+	var bundle models.JobBundle
+	if err = cli.deserializeAPIResponse(resp, &bundle, &jsonapi.Links{}); err != nil {
+		return cli.errorOut(err)
+	}
+	output, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	if path := c.String("output"); path != "" {
+		return cli.errorOut(ioutil.WriteFile(path, append(output, '\n'), 0600))
+	}
+	fmt.Println(string(output))
+	return nil
+}
+
+// ImportJobBundle adds the jobs, bridges and service agreements of a bundle
+// exported from another node
+func (cli *Client) ImportJobBundle(c *clipkg.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass in the bundle [JSON blob | JSON filepath]"))
+	}
+
+	buf, err := getBufferFromJSON(c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/job_bundles", buf)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+
+	var report models.JobBundleImport
+	return cli.renderAPIResponse(resp, &report)
+}
+
 // ArchiveJobSpec soft deletes a job and its associated runs.
 func (cli *Client) ArchiveJobSpec(c *clipkg.Context) error {
 	if !c.Args().Present() {
@@ -244,6 +354,16 @@ func (cli *Client) IndexBridges(c *clipkg.Context) error {
 	return cli.getPage("/v2/bridge_types", c.Int("page"), &[]models.BridgeType{})
 }
 
+// IndexAuditLog lists the administrative actions taken on the node, newest
+// first, optionally for a single action.
+func (cli *Client) IndexAuditLog(c *clipkg.Context) error {
+	requestURI := "/v2/audit_log"
+	if action := c.String("action"); action != "" {
+		requestURI += "?" + url.Values{"action": {action}}.Encode()
+	}
+	return cli.getPage(requestURI, c.Int("page"), &[]models.AuditLogEntry{})
+}
+
 func (cli *Client) getPage(requestURI string, page int, model interface{}) error {
 	uri, err := url.Parse(requestURI)
 	if err != nil {
@@ -1,10 +1,12 @@
 package cmd_test
 
 import (
+	"errors"
 	"flag"
 	"io/ioutil"
 	"math/big"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -14,6 +16,7 @@ import (
 	"chainlink/core/store/models"
 	"chainlink/core/store/presenters"
 	"chainlink/core/utils"
+	"chainlink/core/web"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
@@ -125,6 +128,18 @@ func TestClient_IndexJobRuns(t *testing.T) {
 	assert.JSONEq(t, `{"a":"b"}`, runs[0].Result.Data.String())
 	assert.Equal(t, jr1.ID, runs[1].ID)
 	assert.JSONEq(t, `{"x":"y"}`, runs[1].Result.Data.String())
+
+	jr1.SetError(errors.New("consumer reverted"))
+	require.NoError(t, app.Store.SaveJobRun(&jr1))
+
+	set := flag.NewFlagSet("test", 0)
+	set.Var(&cli.StringSlice{}, "status", "")
+	set.String("error", "", "")
+	require.NoError(t, set.Parse([]string{"--status", "errored", "--status", "completed", "--error", "reverted"}))
+	require.Nil(t, client.IndexJobRuns(cli.NewContext(nil, set, nil)))
+	runs = *r.Renders[1].(*[]presenters.JobRun)
+	require.Len(t, runs, 1)
+	assert.Equal(t, jr1.ID, runs[0].ID)
 }
 
 func TestClient_ShowJobSpec_Exists(t *testing.T) {
@@ -324,6 +339,63 @@ func TestClient_CreateJobSpec(t *testing.T) {
 	}
 }
 
+func TestClient_SimulateJobSpec(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	client, r := app.NewClientAndRenderer()
+
+	set := flag.NewFlagSet("simulate", 0)
+	set.Parse([]string{
+		`{"initiators":[{"type":"web"}],"tasks":[{"type":"multiply","params":{"times":100}}]}`,
+		`{"result":"1.5"}`,
+	})
+	c := cli.NewContext(nil, set, nil)
+
+	require.NoError(t, client.SimulateJobSpec(c))
+	require.Len(t, r.Renders, 1)
+	simulation := r.Renders[0].(*web.JobSimulation)
+	require.Len(t, simulation.Tasks, 1)
+	assert.Equal(t, "150", simulation.Tasks[0].Result.Get("result").String())
+	assert.Len(t, cltest.AllJobs(t, app.Store), 0)
+}
+
+func TestClient_ExportImportJobBundle(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	job := cltest.NewJobWithWebInitiator()
+	require.NoError(t, app.AddJob(job))
+
+	client, r := app.NewClientAndRenderer()
+
+	dir, err := ioutil.TempDir("", "job_bundle")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "bundle.json")
+
+	set := flag.NewFlagSet("export", 0)
+	set.String("output", path, "")
+	set.Parse([]string{job.ID.String()})
+	require.NoError(t, client.ExportJobBundle(cli.NewContext(nil, set, nil)))
+
+	set = flag.NewFlagSet("import", 0)
+	set.Parse([]string{path})
+	require.NoError(t, client.ImportJobBundle(cli.NewContext(nil, set, nil)))
+
+	require.Len(t, r.Renders, 1)
+	report := r.Renders[0].(*models.JobBundleImport)
+	require.Len(t, report.Jobs, 1)
+	assert.Equal(t, job.ID.String(), report.Jobs[0].ID)
+	assert.Equal(t, models.BundleImportUnchanged, report.Jobs[0].Status)
+}
+
 func TestClient_ArchiveJobSpec(t *testing.T) {
 	t.Parallel()
 
@@ -482,6 +554,35 @@ func TestClient_IndexBridges(t *testing.T) {
 	assert.Equal(t, bt1.Name, bridges[0].Name)
 }
 
+func TestClient_IndexAuditLog(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	for _, action := range []models.AuditAction{models.AuditJobCreated, models.AuditJobArchived} {
+		entry, err := models.NewAuditLogEntry(models.AuditActorSession, cltest.APIEmail, action, "target", nil)
+		require.NoError(t, err)
+		require.NoError(t, app.Store.CreateAuditLogEntry(&entry))
+	}
+
+	client, r := app.NewClientAndRenderer()
+
+	require.NoError(t, client.IndexAuditLog(cltest.EmptyCLIContext()))
+	entries := *r.Renders[0].(*[]models.AuditLogEntry)
+	require.Len(t, entries, 2)
+	assert.Equal(t, models.AuditJobArchived, entries[0].Action)
+
+	set := flag.NewFlagSet("audit", 0)
+	set.String("action", "job.created", "")
+	require.NoError(t, client.IndexAuditLog(cli.NewContext(nil, set, nil)))
+	entries = *r.Renders[1].(*[]models.AuditLogEntry)
+	require.Len(t, entries, 1)
+	assert.Equal(t, models.AuditJobCreated, entries[0].Action)
+	assert.Equal(t, cltest.APIEmail, entries[0].Actor)
+}
+
 func TestClient_ShowBridge(t *testing.T) {
 	t.Parallel()
 
@@ -5,6 +5,7 @@ import (
 	"io"
 	"reflect"
 	"strconv"
+	"strings"
 
 	"chainlink/core/logger"
 	"chainlink/core/store/models"
@@ -73,10 +74,16 @@ func (rt RendererTable) Render(v interface{}) error {
 		return rt.renderTx(*typed)
 	case *presenters.ExternalInitiatorAuthentication:
 		return rt.renderExternalInitiatorAuthentication(*typed)
+	case *web.JobSimulation:
+		return rt.renderJobSimulation(*typed)
+	case *models.JobBundleImport:
+		return rt.renderJobBundleImport(*typed)
 	case *web.ConfigPatchResponse:
 		return rt.renderConfigPatchResponse(typed)
 	case *presenters.ConfigWhitelist:
 		return rt.renderConfiguration(*typed)
+	case *[]models.AuditLogEntry:
+		return rt.renderAuditLog(*typed)
 	default:
 		return fmt.Errorf("Unable to render object of type %T: %v", typed, typed)
 	}
@@ -174,25 +181,27 @@ func (rt RendererTable) renderBridges(bridges []models.BridgeType) error {
 }
 
 func (rt RendererTable) renderBridge(bridge models.BridgeType) error {
-	table := rt.newTable([]string{"Name", "URL", "Default Confirmations", "Outgoing Token"})
+	table := rt.newTable([]string{"Name", "URL", "Default Confirmations", "Outgoing Token", "Signing Secret"})
 	table.Append([]string{
 		bridge.Name.String(),
 		bridge.URL.String(),
 		strconv.FormatUint(uint64(bridge.Confirmations), 10),
 		bridge.OutgoingToken,
+		bridge.SigningSecret,
 	})
 	render("Bridge", table)
 	return nil
 }
 
 func (rt RendererTable) renderBridgeAuthentication(bridge models.BridgeTypeAuthentication) error {
-	table := rt.newTable([]string{"Name", "URL", "Default Confirmations", "Incoming Token", "Outgoing Token"})
+	table := rt.newTable([]string{"Name", "URL", "Default Confirmations", "Incoming Token", "Outgoing Token", "Signing Secret"})
 	table.Append([]string{
 		bridge.Name.String(),
 		bridge.URL.String(),
 		strconv.FormatUint(uint64(bridge.Confirmations), 10),
 		bridge.IncomingToken,
 		bridge.OutgoingToken,
+		bridge.SigningSecret,
 	})
 	render("Bridge", table)
 	return nil
@@ -275,6 +284,24 @@ func (rt RendererTable) renderJobRuns(runs []presenters.JobRun) error {
 	return nil
 }
 
+func (rt RendererTable) renderAuditLog(entries []models.AuditLogEntry) error {
+	table := rt.newTable([]string{"ID", "Time", "Actor Type", "Actor", "Action", "Target", "Payload"})
+	for _, e := range entries {
+		table.Append([]string{
+			e.GetID(),
+			utils.ISO8601UTC(e.CreatedAt),
+			string(e.ActorType),
+			e.Actor,
+			string(e.Action),
+			e.Target,
+			e.Payload.String(),
+		})
+	}
+
+	render("Audit Log", table)
+	return nil
+}
+
 func (rt RendererTable) renderAccountBalances(balances []presenters.AccountBalance) error {
 	table := rt.newTable([]string{"Address", "ETH", "LINK"})
 	for _, ab := range balances {
@@ -369,6 +396,54 @@ func (rt RendererTable) renderTxs(txs []presenters.Tx) error {
 	return nil
 }
 
+func (rt RendererTable) renderJobSimulation(simulation web.JobSimulation) error {
+	table := rt.newTable([]string{"Task", "Type", "Status", "Stubbed", "Result", "Transactions", "Error"})
+	for i, task := range simulation.Tasks {
+		id := task.TaskID
+		if id == "" {
+			id = strconv.Itoa(i)
+		}
+		txs := []string{}
+		for _, tx := range task.Transactions {
+			txs = append(txs, tx.To.Hex()+" "+tx.Data.String())
+		}
+		table.Append([]string{
+			id,
+			task.Type.String(),
+			string(task.Status),
+			fmt.Sprint(task.Stubbed),
+			task.Result.String(),
+			strings.Join(txs, "\n"),
+			task.Error,
+		})
+	}
+
+	render("Simulation", table)
+	return nil
+}
+
+func (rt RendererTable) renderJobBundleImport(report models.JobBundleImport) error {
+	table := rt.newTable([]string{"Kind", "ID", "Status", "Error"})
+	appendResults := func(kind string, results []models.BundleImportResult) {
+		for _, result := range results {
+			table.Append([]string{kind, result.ID, string(result.Status), result.Error})
+		}
+	}
+	appendResults("Bridge", report.Bridges)
+	appendResults("Job", report.Jobs)
+	appendResults("Service Agreement", report.ServiceAgreements)
+	render("Imported", table)
+
+	for _, result := range report.Bridges {
+		if result.Bridge != nil {
+			if err := rt.renderBridgeAuthentication(*result.Bridge); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func (rt RendererTable) renderConfigPatchResponse(config *web.ConfigPatchResponse) error {
 	table := rt.newTable([]string{"Config", "Old Value", "New Value"})
 	table.Append([]string{
@@ -10,6 +10,7 @@ import (
 
 	"chainlink/core/cmd"
 	"chainlink/core/internal/cltest"
+	"chainlink/core/services"
 	"chainlink/core/store/models"
 	"chainlink/core/store/presenters"
 	"chainlink/core/utils"
@@ -325,6 +326,77 @@ func TestRendererTable_PatchResponse(t *testing.T) {
 	assert.Regexp(t, regexp.MustCompile("53276"), output)
 }
 
+func TestRendererTable_RenderJobSimulation(t *testing.T) {
+	t.Parallel()
+
+	buffer := bytes.NewBufferString("")
+	r := cmd.RendererTable{Writer: buffer}
+
+	to := cltest.NewAddress()
+	simulation := web.JobSimulation{Tasks: []services.TaskSimulation{{
+		Type:         models.MustNewTaskType("ethtx"),
+		Status:       models.RunStatusPendingConfirmations,
+		Stubbed:      true,
+		Transactions: []services.SimulatedTx{{To: to, Data: []byte{0xba, 0xdc, 0x0d, 0xe5}}},
+	}}}
+
+	assert.NoError(t, r.Render(&simulation))
+	output := buffer.String()
+	assert.Contains(t, output, "ethtx")
+	assert.Contains(t, output, string(models.RunStatusPendingConfirmations))
+	assert.Contains(t, output, to.Hex())
+	assert.Contains(t, output, "0xbadc0de5")
+}
+
+func TestRendererTable_RenderJobBundleImport(t *testing.T) {
+	t.Parallel()
+
+	buffer := bytes.NewBufferString("")
+	r := cmd.RendererTable{Writer: buffer}
+
+	id := models.NewID().String()
+	report := models.JobBundleImport{
+		Jobs: []models.BundleImportResult{
+			{ID: id, Status: models.BundleImportUnchanged},
+			{ID: models.NewID().String(), Status: models.BundleImportCreated, Error: "subscription failed"},
+		},
+		Bridges: []models.BundleImportResult{{
+			ID:     "importedbridge",
+			Status: models.BundleImportCreated,
+			Bridge: &models.BridgeTypeAuthentication{Name: "importedbridge", IncomingToken: "incoming", OutgoingToken: "outgoing"},
+		}},
+	}
+
+	assert.NoError(t, r.Render(&report))
+	output := buffer.String()
+	assert.Contains(t, output, id)
+	assert.Contains(t, output, string(models.BundleImportUnchanged))
+	assert.Contains(t, output, string(models.BundleImportCreated))
+	assert.Contains(t, output, "subscription failed")
+	assert.Contains(t, output, "incoming")
+	assert.Contains(t, output, "outgoing")
+}
+
+func TestRendererTable_RenderAuditLog(t *testing.T) {
+	t.Parallel()
+
+	buffer := bytes.NewBufferString("")
+	r := cmd.RendererTable{Writer: buffer}
+
+	entry, err := models.NewAuditLogEntry(models.AuditActorAPIToken, "user@example.com", models.AuditConfigUpdated, "EthGasPriceDefault", map[string]string{"new": "1"})
+	require.NoError(t, err)
+	entry.ID = 42
+
+	assert.NoError(t, r.Render(&[]models.AuditLogEntry{entry}))
+	output := buffer.String()
+	assert.Contains(t, output, "42")
+	assert.Contains(t, output, string(models.AuditActorAPIToken))
+	assert.Contains(t, output, "user@example.com")
+	assert.Contains(t, output, string(models.AuditConfigUpdated))
+	assert.Contains(t, output, "EthGasPriceDefault")
+	assert.Contains(t, output, `{"new":"1"}`)
+}
+
 func TestRendererTable_RenderUnknown(t *testing.T) {
 	t.Parallel()
 	r := cmd.RendererTable{Writer: ioutil.Discard}
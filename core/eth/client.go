@@ -23,6 +23,8 @@ type Client interface {
 	SendRawTx(hex string) (common.Hash, error)
 	GetTxReceipt(hash common.Hash) (*TxReceipt, error)
 	GetBlockByNumber(hex string) (BlockHeader, error)
+	GetBlockWithTransactions(hex string) (Block, error)
+	GetGasPrice() (*big.Int, error)
 	GetChainID() (*big.Int, error)
 	SubscribeToNewHeads(channel chan<- BlockHeader) (Subscription, error)
 }
@@ -49,10 +51,16 @@ type CallerSubscriberClient struct {
 // a JSON-RPC call with the given arguments and Subscribe registers a subscription,
 // using an open stream to receive updates from ethereum node.
 type CallerSubscriber interface {
-	Call(result interface{}, method string, args ...interface{}) error
+	Caller
 	Subscribe(context.Context, interface{}, ...interface{}) (Subscription, error)
 }
 
+// Caller implements the Call function, which performs a JSON-RPC call with
+// the given arguments.
+type Caller interface {
+	Call(result interface{}, method string, args ...interface{}) error
+}
+
 // GetNonce returns the nonce (transaction count) for a given address.
 func (client *CallerSubscriberClient) GetNonce(address common.Address) (uint64, error) {
 	result := ""
@@ -122,6 +130,21 @@ func (client *CallerSubscriberClient) GetBlockByNumber(hex string) (BlockHeader,
 	return header, err
 }
 
+// GetBlockWithTransactions returns the block for the passed hex, or "latest",
+// "earliest", "pending", along with its transactions.
+func (client *CallerSubscriberClient) GetBlockWithTransactions(hex string) (Block, error) {
+	var block Block
+	err := client.Call(&block, "eth_getBlockByNumber", hex, true)
+	return block, err
+}
+
+// GetGasPrice returns the gas price suggested by the ethereum node.
+func (client *CallerSubscriberClient) GetGasPrice() (*big.Int, error) {
+	value := new(utils.Big)
+	err := client.Call(value, "eth_gasPrice")
+	return value.ToInt(), err
+}
+
 // GetLogs returns all logs that respect the passed filter query.
 func (client *CallerSubscriberClient) GetLogs(q ethereum.FilterQuery) ([]Log, error) {
 	var results []Log
@@ -0,0 +1,488 @@
+package eth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"chainlink/core/logger"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+)
+
+const (
+	// endpointSmoothing is the weight given to the latest call when updating
+	// an endpoint's moving averages of error rate and latency.
+	endpointSmoothing = 0.2
+	// endpointMaxErrorRate is the error rate above which an endpoint is
+	// considered unhealthy.
+	endpointMaxErrorRate = 0.5
+	// endpointLatencyResolution is the step in which the latencies of
+	// endpoints are compared, so that requests don't flap between endpoints
+	// that are about as fast as each other.
+	endpointLatencyResolution = 10 * time.Millisecond
+)
+
+// EndpointStatus reports the health of an Ethereum node in an EndpointPool.
+type EndpointStatus struct {
+	URL       string        `json:"url"`
+	Primary   bool          `json:"primary"`
+	Active    bool          `json:"active"`
+	Healthy   bool          `json:"healthy"`
+	Head      uint64        `json:"head"`
+	HeadLag   uint64        `json:"headLag"`
+	ErrorRate float64       `json:"errorRate"`
+	Latency   time.Duration `json:"latency"`
+	LastError string        `json:"lastError,omitempty"`
+	CheckedAt *time.Time    `json:"checkedAt,omitempty"`
+}
+
+// EndpointPool is a CallerSubscriber that spreads requests over several
+// Ethereum nodes. Requests go to the healthiest endpoint, preferring
+// primaries over secondaries, and fail over to the next endpoint when a node
+// cannot be reached.
+//
+// Endpoints are scored by how far their head lags behind the others and the
+// rate of failed requests, and the faster of equally healthy endpoints is
+// preferred. When the endpoint serving a subscription becomes unhealthy the
+// subscription errors, so that subscribers resubscribe to a healthy endpoint.
+type EndpointPool struct {
+	endpoints     []*endpoint
+	broadcast     bool
+	maxHeadLag    uint64
+	mutex         sync.Mutex
+	subscriptions map[*endpointSubscription]struct{}
+	chStop        chan struct{}
+	wgDone        sync.WaitGroup
+	startOnce     sync.Once
+	stopOnce      sync.Once
+}
+
+// NewEndpointPool returns an empty pool. When broadcast is set, raw
+// transactions are sent to every endpoint. Endpoints whose head is more than
+// maxHeadLag blocks behind the highest head are unhealthy, unless maxHeadLag
+// is 0.
+func NewEndpointPool(broadcast bool, maxHeadLag uint64) *EndpointPool {
+	return &EndpointPool{
+		broadcast:     broadcast,
+		maxHeadLag:    maxHeadLag,
+		subscriptions: make(map[*endpointSubscription]struct{}),
+		chStop:        make(chan struct{}),
+	}
+}
+
+// Add adds an Ethereum node to the pool. Endpoints are preferred in the order
+// they are added, primaries before secondaries.
+func (p *EndpointPool) Add(rawurl string, primary bool, client CallerSubscriber) {
+	p.endpoints = append(p.endpoints, &endpoint{
+		url:     redactURL(rawurl),
+		primary: primary,
+		client:  client,
+	})
+}
+
+// Start checks the health of the endpoints every interval, in the
+// background. There is nothing to fail over to, and so nothing to check,
+// with a single endpoint.
+func (p *EndpointPool) Start(interval time.Duration) {
+	if len(p.endpoints) < 2 || interval <= 0 {
+		return
+	}
+	p.startOnce.Do(func() {
+		p.wgDone.Add(1)
+		go p.checkHealth(interval)
+	})
+}
+
+// Stop stops checking the health of the endpoints.
+func (p *EndpointPool) Stop() {
+	p.stopOnce.Do(func() {
+		close(p.chStop)
+	})
+	p.wgDone.Wait()
+}
+
+// Status returns the health of each endpoint, in the order they were added.
+func (p *EndpointPool) Status() []EndpointStatus {
+	active := p.active()
+	statuses := make([]EndpointStatus, len(p.endpoints))
+	for i, e := range p.endpoints {
+		statuses[i] = e.status(p.maxHeadLag)
+		statuses[i].Active = e == active
+	}
+	return statuses
+}
+
+// Call sends the request to the best endpoint, failing over to the others
+// when it cannot be reached. Errors returned by a node are not retried.
+func (p *EndpointPool) Call(result interface{}, method string, args ...interface{}) error {
+	endpoints := p.ranked()
+	if p.broadcast && method == "eth_sendRawTransaction" && len(endpoints) > 1 {
+		return broadcastCall(endpoints, result, method, args...)
+	}
+
+	var merr error
+	for _, e := range endpoints {
+		err := e.call(result, method, args...)
+		if !isConnectionError(err) {
+			return err
+		}
+		logger.Warnw(fmt.Sprintf("Ethereum node %v call failed", e.url), "method", method, "err", err)
+		merr = multierr.Append(merr, err)
+	}
+	return merr
+}
+
+// Subscribe subscribes through the best endpoint that accepts the
+// subscription.
+func (p *EndpointPool) Subscribe(ctx context.Context, channel interface{}, args ...interface{}) (Subscription, error) {
+	var merr error
+	for _, e := range p.ranked() {
+		sub, err := e.subscribe(ctx, channel, args...)
+		if err == nil {
+			return p.track(e, sub), nil
+		}
+		logger.Warnw(fmt.Sprintf("Ethereum node %v subscription failed", e.url), "err", err)
+		merr = multierr.Append(merr, err)
+	}
+	return nil, merr
+}
+
+// broadcastCall sends the request to every endpoint at once, returning the
+// first successful response, or every error if none succeeded.
+func broadcastCall(endpoints []*endpoint, result interface{}, method string, args ...interface{}) error {
+	type response struct {
+		raw json.RawMessage
+		err error
+	}
+	responses := make(chan response, len(endpoints))
+	for _, e := range endpoints {
+		go func(e *endpoint) {
+			var raw json.RawMessage
+			err := e.call(&raw, method, args...)
+			responses <- response{raw, errors.Wrapf(err, "Ethereum node %v", e.url)}
+		}(e)
+	}
+
+	var merr error
+	for range endpoints {
+		r := <-responses
+		if r.err == nil {
+			return json.Unmarshal(r.raw, result)
+		}
+		merr = multierr.Append(merr, r.err)
+	}
+	return merr
+}
+
+// ranked returns the endpoints from best to worst: healthy endpoints before
+// unhealthy ones, then primaries before secondaries, then the fastest first.
+// Unhealthy endpoints are ordered by error rate before anything else.
+func (p *EndpointPool) ranked() []*endpoint {
+	type scored struct {
+		*endpoint
+		healthy   bool
+		errorRate float64
+		latency   int64 // in steps of endpointLatencyResolution
+	}
+	candidates := make([]scored, len(p.endpoints))
+	for i, e := range p.endpoints {
+		status := e.status(p.maxHeadLag)
+		latency := int64(status.Latency / endpointLatencyResolution)
+		candidates[i] = scored{e, status.Healthy, status.ErrorRate, latency}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.healthy != b.healthy {
+			return a.healthy
+		}
+		if !a.healthy && a.errorRate != b.errorRate {
+			return a.errorRate < b.errorRate
+		}
+		if a.primary != b.primary {
+			return a.primary
+		}
+		return a.latency < b.latency
+	})
+
+	endpoints := make([]*endpoint, len(candidates))
+	for i, c := range candidates {
+		endpoints[i] = c.endpoint
+	}
+	return endpoints
+}
+
+func (p *EndpointPool) active() *endpoint {
+	if len(p.endpoints) == 0 {
+		return nil
+	}
+	return p.ranked()[0]
+}
+
+func (p *EndpointPool) checkHealth(interval time.Duration) {
+	defer p.wgDone.Done()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.chStop:
+			return
+		case <-ticker.C:
+			p.checkEndpoints(interval)
+		}
+	}
+}
+
+// checkEndpoints fetches the head of every endpoint, then fails over the
+// subscriptions of any endpoint that has become unhealthy.
+func (p *EndpointPool) checkEndpoints(timeout time.Duration) {
+	var wg sync.WaitGroup
+	for _, e := range p.endpoints {
+		wg.Add(1)
+		go func(e *endpoint) {
+			defer wg.Done()
+			e.checkHead(timeout)
+		}(e)
+	}
+	wg.Wait()
+
+	var highest uint64
+	for _, e := range p.endpoints {
+		if head := e.status(p.maxHeadLag).Head; head > highest {
+			highest = head
+		}
+	}
+	for _, e := range p.endpoints {
+		e.setHighestHead(highest)
+	}
+
+	p.failOver()
+}
+
+// failOver errors the subscriptions served by unhealthy endpoints, as long as
+// there is a healthy endpoint to resubscribe to.
+func (p *EndpointPool) failOver() {
+	active := p.active()
+	if active == nil || !active.status(p.maxHeadLag).Healthy {
+		return
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	for sub := range p.subscriptions {
+		if sub.endpoint != active && !sub.endpoint.status(p.maxHeadLag).Healthy {
+			logger.Warnf("Ethereum node %v is unhealthy, failing over to %v", sub.endpoint.url, active.url)
+			sub.fail(fmt.Errorf("Ethereum node %v is unhealthy", sub.endpoint.url))
+		}
+	}
+}
+
+func (p *EndpointPool) track(e *endpoint, inner Subscription) *endpointSubscription {
+	sub := &endpointSubscription{
+		Subscription: inner,
+		endpoint:     e,
+		pool:         p,
+		errors:       make(chan error, 1),
+		chStop:       make(chan struct{}),
+	}
+	p.mutex.Lock()
+	p.subscriptions[sub] = struct{}{}
+	p.mutex.Unlock()
+	go sub.forwardErrors()
+	return sub
+}
+
+func (p *EndpointPool) untrack(sub *endpointSubscription) {
+	p.mutex.Lock()
+	delete(p.subscriptions, sub)
+	p.mutex.Unlock()
+}
+
+type endpoint struct {
+	url     string
+	primary bool
+	client  CallerSubscriber
+
+	mutex       sync.RWMutex
+	head        uint64
+	highestHead uint64
+	errorRate   float64
+	latency     time.Duration
+	lastError   string
+	checkedAt   *time.Time
+	checking    bool
+}
+
+func (e *endpoint) call(result interface{}, method string, args ...interface{}) error {
+	start := time.Now()
+	err := e.client.Call(result, method, args...)
+	e.record(time.Since(start), err)
+	return err
+}
+
+func (e *endpoint) subscribe(ctx context.Context, channel interface{}, args ...interface{}) (Subscription, error) {
+	start := time.Now()
+	sub, err := e.client.Subscribe(ctx, channel, args...)
+	e.record(time.Since(start), err)
+	return sub, err
+}
+
+// checkHead fetches the endpoint's latest block number, counting it as a
+// failure if the node does not answer within the timeout.
+func (e *endpoint) checkHead(timeout time.Duration) {
+	e.mutex.Lock()
+	if e.checking {
+		e.mutex.Unlock()
+		e.recordFailure(errors.New("previous health check has not finished"))
+		return
+	}
+	e.checking = true
+	e.mutex.Unlock()
+
+	done := make(chan uint64, 1)
+	go func() {
+		defer func() {
+			e.mutex.Lock()
+			e.checking = false
+			e.mutex.Unlock()
+		}()
+		var result hexutil.Uint64
+		if err := e.call(&result, "eth_blockNumber"); err == nil {
+			done <- uint64(result)
+		}
+		close(done)
+	}()
+
+	select {
+	case head, ok := <-done:
+		if ok {
+			now := time.Now()
+			e.mutex.Lock()
+			e.head = head
+			e.checkedAt = &now
+			e.mutex.Unlock()
+		}
+	case <-time.After(timeout):
+		e.recordFailure(fmt.Errorf("health check timed out after %v", timeout))
+	}
+}
+
+func (e *endpoint) record(latency time.Duration, err error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if e.latency == 0 {
+		e.latency = latency
+	} else {
+		e.latency = time.Duration(endpointSmoothing*float64(latency) + (1-endpointSmoothing)*float64(e.latency))
+	}
+	if isConnectionError(err) {
+		e.errorRate = endpointSmoothing + (1-endpointSmoothing)*e.errorRate
+		e.lastError = err.Error()
+	} else {
+		e.errorRate = (1 - endpointSmoothing) * e.errorRate
+	}
+}
+
+func (e *endpoint) recordFailure(err error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.errorRate = endpointSmoothing + (1-endpointSmoothing)*e.errorRate
+	e.lastError = err.Error()
+}
+
+func (e *endpoint) setHighestHead(highest uint64) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.highestHead = highest
+}
+
+func (e *endpoint) status(maxHeadLag uint64) EndpointStatus {
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+
+	var lag uint64
+	if e.highestHead > e.head {
+		lag = e.highestHead - e.head
+	}
+	return EndpointStatus{
+		URL:       e.url,
+		Primary:   e.primary,
+		Healthy:   e.errorRate < endpointMaxErrorRate && (maxHeadLag == 0 || lag <= maxHeadLag),
+		Head:      e.head,
+		HeadLag:   lag,
+		ErrorRate: e.errorRate,
+		Latency:   e.latency,
+		LastError: e.lastError,
+		CheckedAt: e.checkedAt,
+	}
+}
+
+// endpointSubscription is a subscription through one of the endpoints of a
+// pool, which errors when its endpoint fails or becomes unhealthy.
+type endpointSubscription struct {
+	Subscription
+	endpoint        *endpoint
+	pool            *EndpointPool
+	errors          chan error
+	chStop          chan struct{}
+	unsubscribeOnce sync.Once
+}
+
+func (sub *endpointSubscription) Err() <-chan error {
+	return sub.errors
+}
+
+func (sub *endpointSubscription) Unsubscribe() {
+	sub.unsubscribeOnce.Do(func() {
+		close(sub.chStop)
+		sub.pool.untrack(sub)
+		sub.Subscription.Unsubscribe()
+	})
+}
+
+func (sub *endpointSubscription) forwardErrors() {
+	select {
+	case err, open := <-sub.Subscription.Err():
+		if open && err != nil {
+			sub.endpoint.recordFailure(err)
+			sub.fail(err)
+		}
+	case <-sub.chStop:
+	}
+}
+
+func (sub *endpointSubscription) fail(err error) {
+	select {
+	case sub.errors <- err:
+	default:
+	}
+}
+
+// isConnectionError returns true if the request did not get an answer from
+// the node, as opposed to the node answering with an error.
+func isConnectionError(err error) bool {
+	if err == nil {
+		return false
+	}
+	_, ok := errors.Cause(err).(rpc.Error)
+	return !ok
+}
+
+// redactURL removes any credentials from the URL.
+func redactURL(rawurl string) string {
+	u, err := url.Parse(rawurl)
+	if err != nil || u.User == nil {
+		return rawurl
+	}
+	u.User = nil
+	return u.String()
+}
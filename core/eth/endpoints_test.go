@@ -0,0 +1,217 @@
+package eth_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"chainlink/core/eth"
+	"chainlink/core/internal/cltest"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type nodeError struct{ message string }
+
+func (e nodeError) Error() string  { return e.message }
+func (e nodeError) ErrorCode() int { return -32000 }
+
+// fakeNode is a CallerSubscriber that answers every call with the same result.
+type fakeNode struct {
+	mutex  sync.Mutex
+	head   uint64
+	result interface{}
+	err    error
+	delay  time.Duration
+	calls  map[string]int
+}
+
+func newFakeNode(head uint64) *fakeNode {
+	return &fakeNode{head: head, result: "0x1", calls: map[string]int{}}
+}
+
+func (n *fakeNode) Call(result interface{}, method string, args ...interface{}) error {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	time.Sleep(n.delay)
+	n.calls[method]++
+	if n.err != nil {
+		return n.err
+	}
+	response := n.result
+	if method == "eth_blockNumber" {
+		response = hexutil.Uint64(n.head)
+	}
+	b, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, result)
+}
+
+func (n *fakeNode) Subscribe(ctx context.Context, channel interface{}, args ...interface{}) (eth.Subscription, error) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.calls["eth_subscribe"]++
+	if n.err != nil {
+		return nil, n.err
+	}
+	return cltest.EmptyMockSubscription(), nil
+}
+
+func (n *fakeNode) set(head uint64, err error) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.head = head
+	n.err = err
+}
+
+func (n *fakeNode) count(method string) int {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return n.calls[method]
+}
+
+func TestEndpointPool_Call_FailsOverOnConnectionErrors(t *testing.T) {
+	t.Parallel()
+
+	primary, secondary := newFakeNode(1), newFakeNode(1)
+	pool := eth.NewEndpointPool(false, 0)
+	pool.Add("ws://user:pass@primary", true, primary)
+	pool.Add("ws://secondary", false, secondary)
+
+	primary.set(1, errors.New("connection refused"))
+	var result string
+	require.NoError(t, pool.Call(&result, "eth_getBalance"))
+	assert.Equal(t, "0x1", result)
+	assert.Equal(t, 1, primary.count("eth_getBalance"))
+	assert.Equal(t, 1, secondary.count("eth_getBalance"))
+
+	primary.set(1, nodeError{"execution reverted"})
+	assert.EqualError(t, pool.Call(&result, "eth_call"), "execution reverted")
+	assert.Equal(t, 0, secondary.count("eth_call"))
+
+	statuses := pool.Status()
+	require.Len(t, statuses, 2)
+	assert.Equal(t, "ws://primary", statuses[0].URL)
+	assert.True(t, statuses[0].Primary)
+	assert.Equal(t, "connection refused", statuses[0].LastError)
+	assert.True(t, statuses[0].ErrorRate > 0)
+	assert.False(t, statuses[1].Primary)
+}
+
+func TestEndpointPool_Call_PrefersHealthyEndpoints(t *testing.T) {
+	t.Parallel()
+
+	primary, secondary := newFakeNode(1), newFakeNode(1)
+	pool := eth.NewEndpointPool(false, 0)
+	pool.Add("ws://primary", true, primary)
+	pool.Add("ws://secondary", false, secondary)
+
+	primary.set(1, errors.New("connection refused"))
+	var result string
+	for i := 0; i < 4; i++ {
+		require.NoError(t, pool.Call(&result, "eth_getBalance"))
+	}
+	assert.Equal(t, 4, primary.count("eth_getBalance"))
+
+	statuses := pool.Status()
+	assert.False(t, statuses[0].Healthy)
+	assert.False(t, statuses[0].Active)
+	assert.True(t, statuses[1].Active)
+
+	require.NoError(t, pool.Call(&result, "eth_getBalance"))
+	assert.Equal(t, 4, primary.count("eth_getBalance"))
+	assert.Equal(t, 5, secondary.count("eth_getBalance"))
+}
+
+func TestEndpointPool_Call_PrefersFasterEndpoints(t *testing.T) {
+	t.Parallel()
+
+	slow, fast, secondary := newFakeNode(1), newFakeNode(1), newFakeNode(1)
+	slow.delay = 50 * time.Millisecond
+	pool := eth.NewEndpointPool(false, 0)
+	pool.Add("ws://slow", true, slow)
+	pool.Add("ws://fast", true, fast)
+	pool.Add("ws://secondary", false, secondary)
+
+	// The first primary is tried first, until its latency is known
+	var result string
+	require.NoError(t, pool.Call(&result, "eth_getBalance"))
+	assert.Equal(t, 1, slow.count("eth_getBalance"))
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, pool.Call(&result, "eth_getBalance"))
+	}
+	assert.Equal(t, 1, slow.count("eth_getBalance"))
+	assert.Equal(t, 3, fast.count("eth_getBalance"))
+	assert.Equal(t, 0, secondary.count("eth_getBalance"), "primaries should be preferred however fast the secondary is")
+
+	statuses := pool.Status()
+	assert.True(t, statuses[0].Latency > statuses[1].Latency)
+	assert.False(t, statuses[0].Active)
+	assert.True(t, statuses[1].Active)
+}
+
+func TestEndpointPool_Call_BroadcastsTransactions(t *testing.T) {
+	t.Parallel()
+
+	primary, secondary := newFakeNode(1), newFakeNode(1)
+	primary.result = "0x2"
+	secondary.result = "0x2"
+	pool := eth.NewEndpointPool(true, 0)
+	pool.Add("ws://primary", true, primary)
+	pool.Add("ws://secondary", false, secondary)
+
+	secondary.set(1, nodeError{"already known"})
+	var result string
+	require.NoError(t, pool.Call(&result, "eth_sendRawTransaction", "0xabc"))
+	assert.Equal(t, "0x2", result)
+	gomega.NewGomegaWithT(t).Eventually(func() int {
+		return secondary.count("eth_sendRawTransaction")
+	}).Should(gomega.Equal(1))
+	assert.Equal(t, 1, primary.count("eth_sendRawTransaction"))
+
+	require.NoError(t, pool.Call(&result, "eth_getBalance"))
+	assert.Equal(t, 0, secondary.count("eth_getBalance"))
+}
+
+func TestEndpointPool_Start_FailsOverLaggingEndpoints(t *testing.T) {
+	t.Parallel()
+
+	primary, secondary := newFakeNode(10), newFakeNode(10)
+	pool := eth.NewEndpointPool(false, 5)
+	pool.Add("ws://primary", true, primary)
+	pool.Add("ws://secondary", false, secondary)
+
+	sub, err := pool.Subscribe(context.Background(), make(chan struct{}), "newHeads")
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+	assert.Equal(t, 1, primary.count("eth_subscribe"))
+
+	pool.Start(10 * time.Millisecond)
+	defer pool.Stop()
+
+	g := gomega.NewGomegaWithT(t)
+	g.Eventually(func() uint64 { return pool.Status()[1].Head }).Should(gomega.Equal(uint64(10)))
+	g.Consistently(sub.Err()).ShouldNot(gomega.Receive())
+
+	secondary.set(20, nil)
+	g.Eventually(func() uint64 { return pool.Status()[0].HeadLag }).Should(gomega.Equal(uint64(10)))
+	g.Eventually(sub.Err()).Should(gomega.Receive())
+
+	statuses := pool.Status()
+	assert.False(t, statuses[0].Healthy)
+	assert.True(t, statuses[1].Active)
+
+	resubscribed, err := pool.Subscribe(context.Background(), make(chan struct{}), "newHeads")
+	require.NoError(t, err)
+	defer resubscribed.Unsubscribe()
+	assert.Equal(t, 1, secondary.count("eth_subscribe"))
+}
@@ -0,0 +1,243 @@
+package eth
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"chainlink/core/logger"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+)
+
+// errPollingStopped is returned by a poll cut short by unsubscribing.
+var errPollingStopped = errors.New("polling stopped")
+
+const (
+	// maxLogPollRange is the most blocks requested by a single eth_getLogs
+	// call while polling for logs.
+	maxLogPollRange = 1000
+	// maxFailedPolls is the number of polls in a row that may fail before
+	// the subscription errors.
+	maxFailedPolls = 3
+)
+
+// Poller emulates "newHeads" and "logs" subscriptions by polling the node
+// every interval, for transports without notifications such as HTTP.
+//
+// Heads are sent whenever the latest block changes. Logs are fetched with
+// eth_getLogs over the blocks mined since the previous poll, starting from
+// the block after the latest one when subscribing, like a websocket
+// subscription. Unlike a websocket subscription, logs are not sent again
+// marked as removed after a chain reorganization.
+//
+// When a poll fails the error is logged and the same blocks are polled again
+// on the next interval, so that no logs are missed while the node is briefly
+// unreachable. After maxFailedPolls failed polls in a row the subscription
+// sends the error on Err and stops polling, like a websocket subscription
+// whose connection is lost, so that subscribers resubscribe. Log
+// subscriptions share one eth_blockNumber call per interval, so polling N of
+// them costs about N+1 calls per interval.
+type Poller struct {
+	caller   Caller
+	interval time.Duration
+
+	latestMutex sync.Mutex
+	latest      uint64
+	latestAt    time.Time
+}
+
+// NewPoller returns a Poller calling the node every interval.
+func NewPoller(caller Caller, interval time.Duration) *Poller {
+	return &Poller{caller: caller, interval: interval}
+}
+
+// Subscribe starts polling for the subscription named by the first of args
+// into channel.
+func (p *Poller) Subscribe(channel interface{}, args ...interface{}) (Subscription, error) {
+	ch := reflect.ValueOf(channel)
+	if ch.Kind() != reflect.Chan || ch.Type().ChanDir()&reflect.SendDir == 0 {
+		return nil, fmt.Errorf("cannot poll into %T, which is not a writable channel", channel)
+	}
+	if len(args) == 0 {
+		return nil, errors.New("subscription name is required")
+	}
+
+	sub := &pollingSubscription{
+		poller:  p,
+		channel: ch,
+		errors:  make(chan error, 1),
+		chStop:  make(chan struct{}),
+		chDone:  make(chan struct{}),
+	}
+
+	var poll func() error
+	switch args[0] {
+	case "newHeads":
+		poll = sub.pollHeads()
+	case "logs":
+		var err error
+		poll, err = sub.pollLogs(args[1:]...)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("cannot poll for %v subscriptions", args[0])
+	}
+
+	go sub.run(p.interval, poll)
+	return sub, nil
+}
+
+// latestBlock returns the number of the latest block, calling the node at
+// most once per interval however many subscriptions ask for it.
+func (p *Poller) latestBlock() (uint64, error) {
+	p.latestMutex.Lock()
+	defer p.latestMutex.Unlock()
+
+	if !p.latestAt.IsZero() && time.Since(p.latestAt) < p.interval {
+		return p.latest, nil
+	}
+	var latest hexutil.Uint64
+	if err := p.caller.Call(&latest, "eth_blockNumber"); err != nil {
+		return 0, err
+	}
+	p.latest, p.latestAt = uint64(latest), time.Now()
+	return p.latest, nil
+}
+
+type pollingSubscription struct {
+	poller          *Poller
+	channel         reflect.Value
+	errors          chan error
+	chStop          chan struct{}
+	chDone          chan struct{}
+	unsubscribeOnce sync.Once
+}
+
+func (sub *pollingSubscription) Err() <-chan error {
+	return sub.errors
+}
+
+// Unsubscribe stops polling, waiting for any poll in progress so that
+// nothing is sent on the channel afterwards.
+func (sub *pollingSubscription) Unsubscribe() {
+	sub.unsubscribeOnce.Do(func() {
+		close(sub.chStop)
+	})
+	<-sub.chDone
+}
+
+func (sub *pollingSubscription) run(interval time.Duration, poll func() error) {
+	defer close(sub.chDone)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	var failed int
+	for {
+		select {
+		case <-sub.chStop:
+			return
+		case <-ticker.C:
+			err := poll()
+			switch {
+			case err == errPollingStopped:
+				return
+			case err == nil:
+				failed = 0
+			case failed+1 < maxFailedPolls:
+				failed++
+				logger.Warnw("Polling Ethereum node failed, retrying on the next interval", "err", err, "failed", failed)
+			default:
+				sub.errors <- errors.Wrapf(err, "%d polls failed in a row", maxFailedPolls)
+				return
+			}
+		}
+	}
+}
+
+func (sub *pollingSubscription) pollHeads() func() error {
+	var last common.Hash
+	return func() error {
+		var raw json.RawMessage
+		if err := sub.poller.caller.Call(&raw, "eth_getBlockByNumber", "latest", false); err != nil {
+			return errors.Wrap(err, "polling for heads")
+		}
+		var head BlockHeader
+		if err := json.Unmarshal(raw, &head); err != nil {
+			return errors.Wrap(err, "polling for heads")
+		}
+		if head.Hash() == last {
+			return nil
+		}
+		last = head.Hash()
+		return sub.send(raw)
+	}
+}
+
+func (sub *pollingSubscription) pollLogs(args ...interface{}) (func() error, error) {
+	filter := map[string]interface{}{}
+	if len(args) > 0 {
+		arg, ok := args[0].(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("cannot poll for logs with filter %T", args[0])
+		}
+		for key, value := range arg {
+			filter[key] = value
+		}
+	}
+
+	latest, err := sub.poller.latestBlock()
+	if err != nil {
+		return nil, errors.Wrap(err, "polling for logs")
+	}
+	from := latest + 1
+
+	return func() error {
+		latest, err := sub.poller.latestBlock()
+		if err != nil {
+			return errors.Wrap(err, "polling for logs")
+		}
+		for from <= latest {
+			to := from + maxLogPollRange - 1
+			if to > latest {
+				to = latest
+			}
+			filter["fromBlock"] = hexutil.EncodeUint64(from)
+			filter["toBlock"] = hexutil.EncodeUint64(to)
+
+			var logs []json.RawMessage
+			if err := sub.poller.caller.Call(&logs, "eth_getLogs", filter); err != nil {
+				return errors.Wrap(err, "polling for logs")
+			}
+			for _, log := range logs {
+				if err := sub.send(log); err != nil {
+					return err
+				}
+			}
+			from = to + 1
+		}
+		return nil
+	}, nil
+}
+
+// send decodes the JSON into the channel's element type and sends it,
+// unless the subscription stops first.
+func (sub *pollingSubscription) send(raw json.RawMessage) error {
+	value := reflect.New(sub.channel.Type().Elem())
+	if err := json.Unmarshal(raw, value.Interface()); err != nil {
+		return errors.Wrapf(err, "decoding %v", value.Elem().Type())
+	}
+	chosen, _, _ := reflect.Select([]reflect.SelectCase{
+		{Dir: reflect.SelectSend, Chan: sub.channel, Send: value.Elem()},
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(sub.chStop)},
+	})
+	if chosen == 1 {
+		return errPollingStopped
+	}
+	return nil
+}
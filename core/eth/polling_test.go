@@ -0,0 +1,209 @@
+package eth_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"chainlink/core/eth"
+	"chainlink/core/internal/cltest"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pollingNode is a Caller with a chain of empty blocks, which returns a log
+// for every block in a range.
+type pollingNode struct {
+	mutex     sync.Mutex
+	head      uint64
+	logRanges [][2]string
+	calls     map[string]int
+	err       error
+	failures  int // calls left to fail with err, or 0 to fail every call
+}
+
+func (n *pollingNode) Call(result interface{}, method string, args ...interface{}) error {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	if n.calls == nil {
+		n.calls = map[string]int{}
+	}
+	n.calls[method]++
+	if n.err != nil {
+		err := n.err
+		if n.failures > 0 {
+			if n.failures--; n.failures == 0 {
+				n.err = nil
+			}
+		}
+		return err
+	}
+
+	var response interface{}
+	switch method {
+	case "eth_blockNumber":
+		response = hexutil.Uint64(n.head)
+	case "eth_getBlockByNumber":
+		response = map[string]interface{}{
+			"number": hexutil.Uint64(n.head),
+			"hash":   common.BigToHash(new(big.Int).SetUint64(n.head)),
+		}
+	case "eth_getLogs":
+		filter := args[0].(map[string]interface{})
+		from, to := filter["fromBlock"].(string), filter["toBlock"].(string)
+		n.logRanges = append(n.logRanges, [2]string{from, to})
+		logs := []eth.Log{}
+		for block := hexutil.MustDecodeUint64(from); block <= hexutil.MustDecodeUint64(to); block++ {
+			logs = append(logs, eth.Log{
+				BlockNumber: block,
+				Address:     filter["address"].(common.Address),
+				Topics:      []common.Hash{},
+				Data:        []byte{},
+			})
+		}
+		response = logs
+	}
+
+	b, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, result)
+}
+
+func (n *pollingNode) mine(blocks uint64) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.head += blocks
+}
+
+func (n *pollingNode) fail(err error, calls int) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.err, n.failures = err, calls
+}
+
+func (n *pollingNode) callCount(method string) int {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return n.calls[method]
+}
+
+func (n *pollingNode) ranges() [][2]string {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return append([][2]string{}, n.logRanges...)
+}
+
+func TestPoller_Subscribe_NewHeads(t *testing.T) {
+	t.Parallel()
+
+	node := &pollingNode{head: 10}
+	heads := make(chan eth.BlockHeader)
+	sub, err := eth.NewPoller(node, 10*time.Millisecond).Subscribe(heads, "newHeads")
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	g := gomega.NewGomegaWithT(t)
+	var head eth.BlockHeader
+	g.Eventually(heads).Should(gomega.Receive(&head))
+	assert.Equal(t, int64(10), head.Number.ToInt().Int64())
+	g.Consistently(heads).ShouldNot(gomega.Receive())
+
+	node.mine(1)
+	g.Eventually(heads).Should(gomega.Receive(&head))
+	assert.Equal(t, int64(11), head.Number.ToInt().Int64())
+}
+
+func TestPoller_Subscribe_Logs(t *testing.T) {
+	t.Parallel()
+
+	node := &pollingNode{head: 10}
+	address := cltest.NewAddress()
+	logs := make(chan eth.Log, 2000)
+	filter := map[string]interface{}{"fromBlock": "0x0", "address": address}
+	sub, err := eth.NewPoller(node, 10*time.Millisecond).Subscribe(logs, "logs", filter)
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	g := gomega.NewGomegaWithT(t)
+	g.Consistently(logs).ShouldNot(gomega.Receive())
+
+	node.mine(1500)
+	g.Eventually(func() int { return len(logs) }).Should(gomega.Equal(1500))
+	assert.Equal(t, [][2]string{{"0xb", "0x3f2"}, {"0x3f3", "0x5e6"}}, node.ranges())
+	assert.Equal(t, "0x0", filter["fromBlock"])
+
+	log := <-logs
+	assert.Equal(t, uint64(11), log.BlockNumber)
+	assert.Equal(t, address, log.Address)
+}
+
+func TestPoller_Subscribe_RetriesOnError(t *testing.T) {
+	t.Parallel()
+
+	node := &pollingNode{head: 10}
+	address := cltest.NewAddress()
+	logs := make(chan eth.Log, 10)
+	filter := map[string]interface{}{"address": address}
+	sub, err := eth.NewPoller(node, 10*time.Millisecond).Subscribe(logs, "logs", filter)
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	// Fewer failed polls in a row than it takes to error the subscription
+	node.fail(assert.AnError, 2)
+	node.mine(2)
+	g := gomega.NewGomegaWithT(t)
+	g.Eventually(func() int { return len(logs) }).Should(gomega.Equal(2))
+	assert.Equal(t, [][2]string{{"0xb", "0xc"}}, node.ranges())
+	g.Consistently(sub.Err()).ShouldNot(gomega.Receive())
+}
+
+func TestPoller_Subscribe_ErrorsAfterRepeatedFailures(t *testing.T) {
+	t.Parallel()
+
+	node := &pollingNode{head: 10}
+	heads := make(chan eth.BlockHeader, 10)
+	sub, err := eth.NewPoller(node, 10*time.Millisecond).Subscribe(heads, "newHeads")
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	node.fail(assert.AnError, 0)
+	g := gomega.NewGomegaWithT(t)
+	g.Eventually(sub.Err()).Should(gomega.Receive(&err))
+	assert.Contains(t, err.Error(), assert.AnError.Error())
+
+	calls := node.callCount("eth_getBlockByNumber")
+	g.Consistently(func() int { return node.callCount("eth_getBlockByNumber") }).Should(gomega.Equal(calls))
+}
+
+func TestPoller_Subscribe_SharesLatestBlock(t *testing.T) {
+	t.Parallel()
+
+	node := &pollingNode{head: 10}
+	poller := eth.NewPoller(node, time.Hour)
+	for i := 0; i < 3; i++ {
+		filter := map[string]interface{}{"address": cltest.NewAddress()}
+		sub, err := poller.Subscribe(make(chan eth.Log), "logs", filter)
+		require.NoError(t, err)
+		defer sub.Unsubscribe()
+	}
+	assert.Equal(t, 1, node.callCount("eth_blockNumber"))
+}
+
+func TestPoller_Subscribe_Unsupported(t *testing.T) {
+	t.Parallel()
+
+	node := &pollingNode{}
+	poller := eth.NewPoller(node, time.Second)
+	_, err := poller.Subscribe(make(chan eth.Log), "newPendingTransactions")
+	assert.Error(t, err)
+	_, err = poller.Subscribe("not a channel", "newHeads")
+	assert.Error(t, err)
+}
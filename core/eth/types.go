@@ -93,12 +93,28 @@ type BlockHeader struct {
 
 var emptyHash = common.Hash{}
 
-// Hash will return GethHash if it exists otherwise it returns the ParityHash
+// Hash will return the block hash if the node sent it, otherwise it returns
+// the GethHash. Hashes must match the ParentHash of the following block for
+// the head tracker to follow the chain.
 func (h BlockHeader) Hash() common.Hash {
-	if h.GethHash != emptyHash {
-		return h.GethHash
+	if h.ParityHash != emptyHash {
+		return h.ParityHash
 	}
-	return h.ParityHash
+	return h.GethHash
+}
+
+// Block represents a block in the Ethereum blockchain, with only the fields
+// of its transactions that are needed to estimate gas prices.
+type Block struct {
+	Number       hexutil.Big   `json:"number"`
+	Hash         common.Hash   `json:"hash"`
+	Transactions []Transaction `json:"transactions"`
+}
+
+// Transaction represents a transaction in a block.
+type Transaction struct {
+	Hash     common.Hash `json:"hash"`
+	GasPrice hexutil.Big `json:"gasPrice"`
 }
 
 // TxReceipt holds the block number and the transaction hash of a signed
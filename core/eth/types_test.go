@@ -119,3 +119,11 @@ func TestModels_Header_UnmarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestModels_Header_Hash_PrefersBlockHash(t *testing.T) {
+	t.Parallel()
+
+	blockHash := cltest.NewHash()
+	header := eth.BlockHeader{GethHash: cltest.NewHash(), ParityHash: blockHash}
+	assert.Equal(t, blockHash, header.Hash())
+}
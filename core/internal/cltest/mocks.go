@@ -599,6 +599,8 @@ type MockHeadTrackable struct {
 	ConnectedCallback func(bn *models.Head)
 	disconnectedCount int32
 	onNewHeadCount    int32
+	onReorgCount      int32
+	ReorgCallback     func(reorg *models.Reorg)
 }
 
 // Connect increases the connected count by one
@@ -631,6 +633,19 @@ func (m *MockHeadTrackable) OnNewHeadCount() int32 {
 	return atomic.LoadInt32(&m.onNewHeadCount)
 }
 
+// OnReorg increases the OnReorgCount count by one
+func (m *MockHeadTrackable) OnReorg(reorg *models.Reorg) {
+	atomic.AddInt32(&m.onReorgCount, 1)
+	if m.ReorgCallback != nil {
+		m.ReorgCallback(reorg)
+	}
+}
+
+// OnReorgCount returns the count of reorgs, safely.
+func (m *MockHeadTrackable) OnReorgCount() int32 {
+	return atomic.LoadInt32(&m.onReorgCount)
+}
+
 // NeverSleeper is a struct that never sleeps
 type NeverSleeper struct{}
 
@@ -8,6 +8,7 @@ import models "chainlink/core/store/models"
 import packr "github.com/gobuffalo/packr"
 
 import store "chainlink/core/store"
+import time "time"
 
 // Application is an autogenerated mock type for the Application type
 type Application struct {
@@ -28,6 +29,29 @@ func (_m *Application) AddJob(job models.JobSpec) error {
 	return r0
 }
 
+// AddJobBundle provides a mock function with given fields: bridges, jobs, sas
+func (_m *Application) AddJobBundle(bridges []models.BridgeType, jobs []models.JobSpec, sas []models.ServiceAgreement) (map[string]error, error) {
+	ret := _m.Called(bridges, jobs, sas)
+
+	var r0 map[string]error
+	if rf, ok := ret.Get(0).(func([]models.BridgeType, []models.JobSpec, []models.ServiceAgreement) map[string]error); ok {
+		r0 = rf(bridges, jobs, sas)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]error)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]models.BridgeType, []models.JobSpec, []models.ServiceAgreement) error); ok {
+		r1 = rf(bridges, jobs, sas)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AddServiceAgreement provides a mock function with given fields: _a0
 func (_m *Application) AddServiceAgreement(_a0 *models.ServiceAgreement) error {
 	ret := _m.Called(_a0)
@@ -197,6 +221,20 @@ func (_m *Application) ResumeAllInProgress() error {
 	return r0
 }
 
+// ResumeAllSleeping provides a mock function with given fields: now
+func (_m *Application) ResumeAllSleeping(now time.Time) error {
+	ret := _m.Called(now)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(time.Time) error); ok {
+		r0 = rf(now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ResumePending provides a mock function with given fields: runID, input
 func (_m *Application) ResumePending(runID *models.ID, input models.BridgeRunResult) error {
 	ret := _m.Called(runID, input)
@@ -239,6 +277,25 @@ func (_m *Application) Stop() error {
 	return r0
 }
 
+// UpdateJob provides a mock function with given fields: job
+func (_m *Application) UpdateJob(job models.JobSpec) error {
+	ret := _m.Called(job)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(models.JobSpec) error); ok {
+		r0 = rf(job)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WakeBulkRunDeleter provides a mock function with given fields:
+func (_m *Application) WakeBulkRunDeleter() {
+	_m.Called()
+}
+
 // WakeSessionReaper provides a mock function with given fields:
 func (_m *Application) WakeSessionReaper() {
 	_m.Called()
@@ -40,6 +40,27 @@ func (_m *Client) GetBlockByNumber(hex string) (eth.BlockHeader, error) {
 	return r0, r1
 }
 
+// GetBlockWithTransactions provides a mock function with given fields: hex
+func (_m *Client) GetBlockWithTransactions(hex string) (eth.Block, error) {
+	ret := _m.Called(hex)
+
+	var r0 eth.Block
+	if rf, ok := ret.Get(0).(func(string) eth.Block); ok {
+		r0 = rf(hex)
+	} else {
+		r0 = ret.Get(0).(eth.Block)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(hex)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetChainID provides a mock function with given fields:
 func (_m *Client) GetChainID() (*big.Int, error) {
 	ret := _m.Called()
@@ -109,6 +130,29 @@ func (_m *Client) GetEthBalance(address common.Address) (*assets.Eth, error) {
 	return r0, r1
 }
 
+// GetGasPrice provides a mock function with given fields:
+func (_m *Client) GetGasPrice() (*big.Int, error) {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetLogs provides a mock function with given fields: q
 func (_m *Client) GetLogs(q ethereum.FilterQuery) ([]eth.Log, error) {
 	ret := _m.Called(q)
@@ -10,6 +10,11 @@ type RunExecutor struct {
 	mock.Mock
 }
 
+// Cancel provides a mock function with given fields: _a0
+func (_m *RunExecutor) Cancel(_a0 *models.ID) {
+	_m.Called(_a0)
+}
+
 // Execute provides a mock function with given fields: _a0
 func (_m *RunExecutor) Execute(_a0 *models.ID) error {
 	ret := _m.Called(_a0)
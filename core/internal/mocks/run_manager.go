@@ -5,6 +5,7 @@ package mocks
 import big "math/big"
 import mock "github.com/stretchr/testify/mock"
 import models "chainlink/core/store/models"
+import time "time"
 
 // RunManager is an autogenerated mock type for the RunManager type
 type RunManager struct {
@@ -122,6 +123,20 @@ func (_m *RunManager) ResumeAllInProgress() error {
 	return r0
 }
 
+// ResumeAllSleeping provides a mock function with given fields: now
+func (_m *RunManager) ResumeAllSleeping(now time.Time) error {
+	ret := _m.Called(now)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(time.Time) error); ok {
+		r0 = rf(now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ResumePending provides a mock function with given fields: runID, input
 func (_m *RunManager) ResumePending(runID *models.ID, input models.BridgeRunResult) error {
 	ret := _m.Called(runID, input)
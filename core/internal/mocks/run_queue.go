@@ -10,6 +10,11 @@ type RunQueue struct {
 	mock.Mock
 }
 
+// Cancel provides a mock function with given fields: _a0
+func (_m *RunQueue) Cancel(_a0 *models.ID) {
+	_m.Called(_a0)
+}
+
 // Run provides a mock function with given fields: _a0
 func (_m *RunQueue) Run(_a0 *models.JobRun) {
 	_m.Called(_a0)
@@ -367,6 +367,11 @@ func (_m *TxManager) OnNewHead(_a0 *models.Head) {
 	_m.Called(_a0)
 }
 
+// OnReorg provides a mock function with given fields: _a0
+func (_m *TxManager) OnReorg(_a0 *models.Reorg) {
+	_m.Called(_a0)
+}
+
 // Register provides a mock function with given fields: _a0
 func (_m *TxManager) Register(_a0 []accounts.Account) {
 	_m.Called(_a0)
@@ -25,9 +25,12 @@ type Application interface {
 	Stop() error
 	GetStore() *store.Store
 	WakeSessionReaper()
+	WakeBulkRunDeleter()
 	AddJob(job models.JobSpec) error
+	UpdateJob(job models.JobSpec) error
 	ArchiveJob(*models.ID) error
 	AddServiceAgreement(*models.ServiceAgreement) error
+	AddJobBundle(bridges []models.BridgeType, jobs []models.JobSpec, sas []models.ServiceAgreement) (map[string]error, error)
 	NewBox() packr.Box
 	RunManager
 }
@@ -44,6 +47,9 @@ type ChainlinkApplication struct {
 	Scheduler                *Scheduler
 	Store                    *store.Store
 	SessionReaper            SleeperTask
+	BulkRunDeleter           SleeperTask
+	RunReaper                *RunReaper
+	SleepResumer             *SleepResumer
 	pendingConnectionResumer *pendingConnectionResumer
 	shutdownOnce             sync.Once
 }
@@ -70,6 +76,9 @@ func NewApplication(config *orm.Config, onConnectCallbacks ...func(Application))
 		Scheduler:                NewScheduler(store, runManager),
 		Store:                    store,
 		SessionReaper:            NewStoreReaper(store),
+		BulkRunDeleter:           NewBulkRunDeleter(store),
+		RunReaper:                NewRunReaper(store),
+		SleepResumer:             NewSleepResumer(runManager, store.Clock),
 		Exiter:                   os.Exit,
 		pendingConnectionResumer: pendingConnectionResumer,
 	}
@@ -107,10 +116,11 @@ func (app *ChainlinkApplication) Start() error {
 		app.Exiter(0)
 	}()
 
-	return multierr.Combine(
+	err := multierr.Combine(
 		app.Store.Start(),
 		app.RunQueue.Start(),
 		app.RunManager.ResumeAllInProgress(),
+		app.SleepResumer.Start(),
 
 		// HeadTracker deliberately started after
 		// RunQueue#resumeRunsSinceLastShutdown since it Connects JobSubscriber
@@ -120,7 +130,13 @@ func (app *ChainlinkApplication) Start() error {
 
 		app.Scheduler.Start(),
 		app.SessionReaper.Start(),
+		app.BulkRunDeleter.Start(),
+		app.RunReaper.Start(),
 	)
+
+	// Carry on with the bulk deletions of runs interrupted by the last shutdown.
+	app.BulkRunDeleter.WakeUp()
+	return err
 }
 
 // Stop allows the application to exit by halting schedules, closing
@@ -132,9 +148,12 @@ func (app *ChainlinkApplication) Stop() error {
 		logger.Info("Gracefully exiting...")
 
 		app.Scheduler.Stop()
+		app.SleepResumer.Stop()
 		merr = multierr.Append(merr, app.HeadTracker.Stop())
 		app.RunQueue.Stop()
 		merr = multierr.Append(merr, app.SessionReaper.Stop())
+		merr = multierr.Append(merr, app.BulkRunDeleter.Stop())
+		merr = multierr.Append(merr, app.RunReaper.Stop())
 		merr = multierr.Append(merr, app.Store.Close())
 	})
 	return merr
@@ -150,6 +169,12 @@ func (app *ChainlinkApplication) WakeSessionReaper() {
 	app.SessionReaper.WakeUp()
 }
 
+// WakeBulkRunDeleter wakes up the bulk run deleter to carry out the bulk
+// deletions of runs in progress.
+func (app *ChainlinkApplication) WakeBulkRunDeleter() {
+	app.BulkRunDeleter.WakeUp()
+}
+
 // AddJob adds a job to the store and the scheduler. If there was
 // an error from adding the job to the store, the job will not be
 // added to the scheduler.
@@ -163,9 +188,34 @@ func (app *ChainlinkApplication) AddJob(job models.JobSpec) error {
 	return app.JobSubscriber.AddJob(job, nil) // nil for latest
 }
 
+// UpdateJob saves the job as a new version of the existing job with the same
+// ID, and swaps the subscriptions and schedules of the previous version for
+// those of the new one. The new version subscribes to logs from the current
+// head before the previous version unsubscribes, so that no logs are missed.
+// Runs already started carry on with the version they were started with.
+func (app *ChainlinkApplication) UpdateJob(job models.JobSpec) error {
+	previous, err := app.Store.FindJob(job.ID)
+	if err != nil {
+		return err
+	}
+	if err = app.Store.UpdateJob(&job); err != nil {
+		return err
+	}
+
+	app.Scheduler.RemoveJob(job.ID)
+	app.Scheduler.AddJob(job)
+	if job.IsLogInitiated() {
+		return app.JobSubscriber.AddJob(job, app.HeadTracker.Head())
+	} else if previous.IsLogInitiated() {
+		return app.JobSubscriber.RemoveJob(job.ID)
+	}
+	return nil
+}
+
 // ArchiveJob silences the job from the system, preventing future job runs.
 func (app *ChainlinkApplication) ArchiveJob(ID *models.ID) error {
 	_ = app.JobSubscriber.RemoveJob(ID)
+	app.Scheduler.RemoveJob(ID)
 	return app.Store.ArchiveJob(ID)
 }
 
@@ -181,6 +231,32 @@ func (app *ChainlinkApplication) AddServiceAgreement(sa *models.ServiceAgreement
 	return app.JobSubscriber.AddJob(sa.JobSpec, nil) // nil for latest
 }
 
+// AddJobBundle saves the bridges, jobs and service agreements of an imported
+// JobBundle in a single transaction, then schedules and subscribes the jobs.
+// The error is only set when nothing was saved. Jobs that were saved but
+// failed to subscribe are returned with their errors, keyed by job ID, and
+// are subscribed again when the node restarts.
+func (app *ChainlinkApplication) AddJobBundle(bridges []models.BridgeType, jobs []models.JobSpec, sas []models.ServiceAgreement) (map[string]error, error) {
+	if err := app.Store.CreateJobBundle(bridges, jobs, sas); err != nil {
+		return nil, err
+	}
+
+	startErrors := map[string]error{}
+	start := func(job models.JobSpec) {
+		app.Scheduler.AddJob(job)
+		if err := app.JobSubscriber.AddJob(job, nil); err != nil { // nil for latest
+			startErrors[job.ID.String()] = err
+		}
+	}
+	for _, job := range jobs {
+		start(job)
+	}
+	for _, sa := range sas {
+		start(sa.JobSpec)
+	}
+	return startErrors, nil
+}
+
 // NewBox returns the packr.Box instance that holds the static assets to
 // be delivered by the router.
 func (app *ChainlinkApplication) NewBox() packr.Box {
@@ -201,3 +277,4 @@ func (p *pendingConnectionResumer) Connect(head *models.Head) error {
 
 func (p *pendingConnectionResumer) Disconnect()            {}
 func (p *pendingConnectionResumer) OnNewHead(*models.Head) {}
+func (p *pendingConnectionResumer) OnReorg(*models.Reorg)  {}
@@ -0,0 +1,58 @@
+package services
+
+import (
+	"chainlink/core/logger"
+	"chainlink/core/store"
+	"chainlink/core/store/models"
+	"chainlink/core/store/orm"
+)
+
+type bulkRunDeleter struct {
+	store *store.Store
+}
+
+// NewBulkRunDeleter creates a task that carries out the bulk deletions of runs
+// in progress, deleting the runs in batches and saving the progress of each
+// deletion after every batch. Deletions interrupted by a shutdown carry on
+// when it is next woken up.
+func NewBulkRunDeleter(store *store.Store) SleeperTask {
+	return NewSleeperTask(&bulkRunDeleter{store: store})
+}
+
+func (brd *bulkRunDeleter) Work() {
+	tasks, err := brd.store.BulkDeleteRunTasksInProgress()
+	if err != nil {
+		logger.Error("unable to find bulk deletions of runs: ", err)
+		return
+	}
+
+	for i := range tasks {
+		brd.delete(&tasks[i])
+	}
+}
+
+func (brd *bulkRunDeleter) delete(task *models.BulkDeleteRunTask) {
+	batchSize := bulkDeleteBatchSize(brd.store.Config)
+	for task.Status == models.BulkTaskStatusInProgress {
+		deleted, err := brd.store.BulkDeleteRunsBatch(&task.Query, batchSize)
+		task.Deleted += deleted
+		if err != nil {
+			task.Status = models.BulkTaskStatusErrored
+			task.Error = err.Error()
+		} else if deleted < batchSize {
+			task.Status = models.BulkTaskStatusCompleted
+		}
+
+		if err := brd.store.SaveBulkDeleteRunTask(task); err != nil {
+			logger.Errorw("Unable to save bulk deletion of runs", "id", task.ID.String(), "error", err)
+			return
+		}
+	}
+}
+
+func bulkDeleteBatchSize(config orm.ConfigReader) int {
+	if batchSize := int(config.BulkDeleteBatchSize()); batchSize > 0 {
+		return batchSize
+	}
+	return 1
+}
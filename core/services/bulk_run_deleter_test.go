@@ -0,0 +1,92 @@
+package services_test
+
+import (
+	"testing"
+	"time"
+
+	"chainlink/core/adapters"
+	"chainlink/core/internal/cltest"
+	"chainlink/core/services"
+	"chainlink/core/store"
+	"chainlink/core/store/models"
+
+	"github.com/jinzhu/gorm"
+	"github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createRunUpdatedAt(t *testing.T, store *store.Store, job models.JobSpec, status models.RunStatus, updatedAt time.Time) models.JobRun {
+	t.Helper()
+
+	run := job.NewRun(job.Initiators[0])
+	run.Status = status
+	require.NoError(t, store.CreateJobRun(&run))
+	require.NoError(t, store.ORM.RawDB(func(db *gorm.DB) error {
+		return db.Model(&run).UpdateColumn("updated_at", updatedAt).Error
+	}))
+	return run
+}
+
+func TestBulkRunDeleter_DeletesInBatches(t *testing.T) {
+	t.Parallel()
+
+	config, cfgCleanup := cltest.NewConfig(t)
+	defer cfgCleanup()
+	config.Set("BULK_DELETE_BATCH_SIZE", 2)
+	store, cleanup := cltest.NewStoreWithConfig(config)
+	defer cleanup()
+
+	job := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{{Type: adapters.TaskTypeNoOp}}
+	require.NoError(t, store.CreateJob(&job))
+	otherJob := cltest.NewJobWithWebInitiator()
+	otherJob.Tasks = []models.TaskSpec{{Type: adapters.TaskTypeNoOp}}
+	require.NoError(t, store.CreateJob(&otherJob))
+
+	old := time.Now().Add(-time.Hour)
+	for i := 0; i < 5; i++ {
+		createRunUpdatedAt(t, store, job, models.RunStatusCompleted, old)
+	}
+	kept := []models.JobRun{
+		createRunUpdatedAt(t, store, job, models.RunStatusInProgress, old),
+		createRunUpdatedAt(t, store, job, models.RunStatusCompleted, time.Now().Add(time.Hour)),
+		createRunUpdatedAt(t, store, otherJob, models.RunStatusCompleted, old),
+	}
+
+	task := models.NewBulkDeleteRunTask(models.BulkDeleteRunRequest{
+		Status:        models.RunStatusCollection{models.RunStatusCompleted},
+		UpdatedBefore: time.Now(),
+		JobSpecID:     job.ID,
+	})
+	require.NoError(t, store.CreateBulkDeleteRunTask(task))
+
+	deleter := services.NewBulkRunDeleter(store)
+	require.NoError(t, deleter.Start())
+	defer deleter.Stop()
+	deleter.WakeUp()
+
+	gomega.NewGomegaWithT(t).Eventually(func() models.BulkTaskStatus {
+		found, err := store.FindBulkDeleteRunTask(task.ID)
+		require.NoError(t, err)
+		return found.Status
+	}).Should(gomega.Equal(models.BulkTaskStatusCompleted))
+
+	found, err := store.FindBulkDeleteRunTask(task.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 5, found.Deleted)
+	assert.Empty(t, found.Error)
+	assert.Equal(t, job.ID, found.Query.JobSpecID)
+
+	count, err := store.JobRunsCountFor(job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	for _, run := range kept {
+		_, err := store.FindJobRun(run.ID)
+		assert.NoError(t, err)
+	}
+
+	inProgress, err := store.BulkDeleteRunTasksInProgress()
+	require.NoError(t, err)
+	assert.Empty(t, inProgress)
+}
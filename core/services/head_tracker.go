@@ -2,6 +2,7 @@ package services
 
 import (
 	"fmt"
+	"math/big"
 	"sync"
 	"time"
 
@@ -12,9 +13,33 @@ import (
 	"chainlink/core/store/presenters"
 	"chainlink/core/utils"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 )
 
+var (
+	promCurrentHead = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "head_tracker_current_head",
+		Help: "The highest seen head number",
+	})
+	promReconnects = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "head_tracker_reconnects_total",
+		Help: "The number of times the head subscription was lost and reconnected",
+	})
+	promReorgs = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "head_tracker_reorgs_total",
+		Help: "The number of chain reorganizations seen",
+	})
+)
+
+// headWindowSize is the number of recent heads kept to follow the chain
+// through their parent hashes, and the deepest reorg that can be traced back
+// to a common ancestor.
+const headWindowSize = 100
+
 // HeadTracker holds and stores the latest block number experienced by this particular node
 // in a thread safe manner. Reconstitutes the last block number from the data
 // store on reboot.
@@ -24,6 +49,7 @@ type HeadTracker struct {
 	headSubscription      eth.Subscription
 	store                 *strpkg.Store
 	head                  *models.Head
+	window                []models.Head
 	headMutex             sync.RWMutex
 	connected             bool
 	sleeper               utils.Sleeper
@@ -102,24 +128,165 @@ func (ht *HeadTracker) Stop() error {
 	return nil
 }
 
-// Save updates the latest block number, if indeed the latest, and persists
-// this number in case of reboot. Thread safe.
+// Save updates the latest block number, if indeed the latest or the head of
+// a reorganized chain, and persists this number in case of reboot. Thread
+// safe.
 func (ht *HeadTracker) Save(n *models.Head) error {
+	_, err := ht.save(n)
+	return err
+}
+
+// save stores the head like Save, returning the reorg that replaced the
+// previous head, if any.
+func (ht *HeadTracker) save(n *models.Head) (*models.Reorg, error) {
 	if n == nil {
-		return errors.New("Cannot save a nil block header")
+		return nil, errors.New("Cannot save a nil block header")
 	}
 
-	ht.headMutex.Lock()
-	if n.GreaterThan(ht.head) {
-		copy := *n
-		ht.head = &copy
+	// The chain is traced without holding the lock, since it may take many
+	// requests to the ethereum node. If the head moved in the meantime, the
+	// trace is repeated against the new head. A head more than headWindowSize
+	// blocks ahead is not traced at all, and starts the window afresh.
+	var reorg *models.Reorg
+	var contiguous bool
+	for {
+		ht.headMutex.RLock()
+		head, window := ht.head, ht.window
+		ht.headMutex.RUnlock()
+
+		contiguous = head == nil || n.Number-head.Number <= headWindowSize
+		if contiguous {
+			reorg = ht.reorgTo(n, head, window)
+		}
+
+		ht.headMutex.Lock()
+		if ht.head == head {
+			break
+		}
 		ht.headMutex.Unlock()
-	} else {
+	}
+
+	if reorg == nil && !n.GreaterThan(ht.head) {
 		ht.headMutex.Unlock()
 		msg := fmt.Sprintf("Cannot save new head confirmation %v because it's equal to or less than current head %v with hash %s", n, ht.head, n.Hash.Hex())
-		return errBlockNotLater{msg}
+		return nil, errBlockNotLater{msg}
+	}
+
+	window := ht.window
+	if !contiguous {
+		logger.Warnw("New head is too far ahead to trace back to the tracked chain, tracking the chain from it", "head", n.Number, "hash", n.Hash.Hex(), "previous", ht.head.Number)
+		window = nil
+	} else if reorg != nil {
+		window = window[len(reorg.Removed):]
+	}
+	copy := *n
+	ht.head = &copy
+	ht.window = append([]models.Head{copy}, window...)
+	if len(ht.window) > headWindowSize {
+		ht.window = ht.window[:headWindowSize]
+	}
+	promCurrentHead.Set(float64(n.Number))
+	ht.headMutex.Unlock()
+
+	if reorg != nil {
+		oldest := reorg.Removed[len(reorg.Removed)-1]
+		if err := ht.store.DeleteHeadsSince(oldest.Number); err != nil {
+			return reorg, err
+		}
+	}
+	return reorg, ht.store.CreateHead(n)
+}
+
+// reorgTo returns the reorg that makes n the head of the chain tracked by
+// head and window, or nil if n follows head. A head without a parent hash
+// cannot be followed and is never treated as a reorg.
+func (ht *HeadTracker) reorgTo(n *models.Head, head *models.Head, window []models.Head) *models.Reorg {
+	if head == nil || n.ParentHash == (common.Hash{}) || n.ParentHash == head.Hash {
+		return nil
+	}
+	for _, h := range window {
+		if h.Hash == n.Hash {
+			return nil
+		}
+	}
+
+	// Tracked heads from this block number on are no longer on the chain.
+	var since int64
+	ancestor, err := ht.commonAncestor(n, window)
+	if err != nil {
+		// Without the chain in between, only a head replacing one already
+		// tracked is known to be a reorg.
+		logger.Warnw("Unable to trace new head back to the tracked chain", "head", n.Number, "hash", n.Hash.Hex(), "err", err)
+		if n.Number > head.Number {
+			return nil
+		}
+		since = n.Number
+	} else if ancestor != nil {
+		if ancestor.Hash == head.Hash {
+			return nil
+		}
+		since = ancestor.Number + 1
+	}
+
+	var removed []models.Head
+	for _, h := range window {
+		if h.Number < since {
+			break
+		}
+		removed = append(removed, h)
+	}
+	if len(removed) == 0 {
+		return nil
+	}
+
+	oldHead := *head
+	newHead := *n
+	reorg := &models.Reorg{
+		CommonAncestor: ancestor,
+		OldHead:        &oldHead,
+		NewHead:        &newHead,
+		Removed:        removed,
+	}
+	if ancestor != nil {
+		reorg.Depth = oldHead.Number - ancestor.Number
+	} else {
+		reorg.Depth = oldHead.Number - removed[len(removed)-1].Number + 1
+	}
+	return reorg
+}
+
+// commonAncestor walks back from n along the chain reported by the ethereum
+// node until it reaches a head in window on that chain. It returns nil if the
+// chains part before the oldest head in window, or more than headWindowSize
+// blocks back from n.
+func (ht *HeadTracker) commonAncestor(n *models.Head, window []models.Head) (*models.Head, error) {
+	if len(window) == 0 {
+		return nil, nil
+	}
+	tracked := map[int64]models.Head{}
+	for _, h := range window {
+		tracked[h.Number] = h
+	}
+	lowest := window[len(window)-1].Number
+	if n.Number-headWindowSize > lowest {
+		lowest = n.Number - headWindowSize
+	}
+
+	number, hash := n.Number-1, n.ParentHash
+	for ; number >= lowest; number-- {
+		if h, ok := tracked[number]; ok && h.Hash == hash {
+			return &h, nil
+		}
+		block, err := ht.store.TxManager.GetBlockByNumber(hexutil.EncodeBig(big.NewInt(number)))
+		if err != nil {
+			return nil, errors.Wrap(err, "TxManager#GetBlockByNumber")
+		}
+		if block.Hash() != hash {
+			return nil, fmt.Errorf("block %v changed while tracing the chain", number)
+		}
+		hash = block.ParentHash
 	}
-	return ht.store.CreateHead(n)
+	return nil, nil
 }
 
 // Head returns the latest block header being tracked, or nil.
@@ -159,6 +326,15 @@ func (ht *HeadTracker) onNewHead(head *models.Head) {
 	}
 }
 
+func (ht *HeadTracker) onReorg(reorg *models.Reorg) {
+	ht.headMutex.Lock()
+	defer ht.headMutex.Unlock()
+
+	for _, trackable := range ht.callbacks {
+		trackable.OnReorg(reorg)
+	}
+}
+
 func (ht *HeadTracker) listenForNewHeads() {
 	defer ht.listenForNewHeadsWg.Done()
 	defer ht.unsubscribeFromHead()
@@ -169,6 +345,7 @@ func (ht *HeadTracker) listenForNewHeads() {
 		}
 		if err := ht.receiveHeaders(); err != nil {
 			logger.Errorw(fmt.Sprintf("Error in new head subscription, unsubscribed: %s", err.Error()), "err", err)
+			promReconnects.Inc()
 			continue
 		} else {
 			return
@@ -176,7 +353,7 @@ func (ht *HeadTracker) listenForNewHeads() {
 	}
 }
 
-// subscribe periodically attempts to connect to the ethereum node via websocket.
+// subscribe periodically attempts to subscribe to new heads from the ethereum node.
 // It returns true on success, and false if cut short by a done request and did not connect.
 func (ht *HeadTracker) subscribe() bool {
 	ht.sleeper.Reset()
@@ -208,12 +385,24 @@ func (ht *HeadTracker) receiveHeaders() error {
 				return errors.New("HeadTracker headers prematurely closed")
 			}
 			head := models.NewHead(block.Number.ToInt(), block.Hash())
+			head.ParentHash = block.ParentHash
 			logger.Debugw(
 				fmt.Sprintf("Received new head %v", presenters.FriendlyBigInt(head.ToInt())),
 				"blockHeight", head.ToInt(),
 				"blockHash", block.Hash(),
-				"hash", head.Hash)
-			if err := ht.Save(head); err != nil {
+				"hash", head.Hash,
+				"parentHash", head.ParentHash)
+			reorg, err := ht.save(head)
+			if reorg != nil {
+				logger.Warnw(
+					fmt.Sprintf("Chain reorganization of depth %v at head %v", reorg.Depth, presenters.FriendlyBigInt(head.ToInt())),
+					"depth", reorg.Depth,
+					"oldHead", reorg.OldHead.Hash.Hex(),
+					"newHead", reorg.NewHead.Hash.Hex())
+				promReorgs.Inc()
+				ht.onReorg(reorg)
+			}
+			if err != nil {
 				switch err.(type) {
 				case errBlockNotLater:
 					logger.Warn(err)
@@ -272,7 +461,15 @@ func (ht *HeadTracker) updateHeadFromDb() error {
 	if err != nil {
 		return err
 	}
+	window, err := ht.store.LastHeads(headWindowSize)
+	if err != nil {
+		return err
+	}
 	ht.head = number
+	ht.window = window
+	if number != nil {
+		promCurrentHead.Set(float64(number.Number))
+	}
 	return nil
 }
 
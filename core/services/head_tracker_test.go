@@ -13,6 +13,7 @@ import (
 	strpkg "chainlink/core/store"
 	"chainlink/core/store/models"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/onsi/gomega"
 	"github.com/stretchr/testify/assert"
@@ -218,3 +219,190 @@ func TestHeadTracker_StartConnectsFromLastSavedHeader(t *testing.T) {
 	g.Eventually(func() *big.Int { return ht.Head().ToInt() }).Should(gomega.Equal(currentBN))
 	assert.NoError(t, ht.Stop())
 }
+
+func TestHeadTracker_Reorg(t *testing.T) {
+	t.Parallel()
+	g := gomega.NewGomegaWithT(t)
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	mocketh := cltest.MockEthOnStore(t, store)
+	headers := make(chan eth.BlockHeader)
+	mocketh.RegisterSubscription("newHeads", headers)
+	mocketh.Register("eth_chainId", store.Config.ChainID())
+
+	var reorgValue atomic.Value
+	checker := &cltest.MockHeadTrackable{ReorgCallback: func(reorg *models.Reorg) {
+		reorgValue.Store(reorg)
+	}}
+	ht := services.NewHeadTracker(store, []strpkg.HeadTrackable{checker}, cltest.NeverSleeper{})
+	assert.Nil(t, ht.Start())
+	defer ht.Stop()
+	g.Eventually(func() int32 { return checker.ConnectedCount() }).Should(gomega.Equal(int32(1)))
+
+	h1 := eth.BlockHeader{Number: cltest.BigHexInt(1), ParityHash: cltest.NewHash()}
+	h2 := eth.BlockHeader{Number: cltest.BigHexInt(2), ParityHash: cltest.NewHash(), ParentHash: h1.Hash()}
+	h3 := eth.BlockHeader{Number: cltest.BigHexInt(3), ParityHash: cltest.NewHash(), ParentHash: h2.Hash()}
+	headers <- h1
+	headers <- h2
+	headers <- h3
+	g.Eventually(func() int32 { return checker.OnNewHeadCount() }).Should(gomega.Equal(int32(3)))
+	assert.Equal(t, int32(0), checker.OnReorgCount())
+
+	// A sibling of the current head replaces it
+	h3b := eth.BlockHeader{Number: cltest.BigHexInt(3), ParityHash: cltest.NewHash(), ParentHash: h2.Hash()}
+	headers <- h3b
+	g.Eventually(func() int32 { return checker.OnReorgCount() }).Should(gomega.Equal(int32(1)))
+	g.Eventually(func() int32 { return checker.OnNewHeadCount() }).Should(gomega.Equal(int32(4)))
+
+	reorg := reorgValue.Load().(*models.Reorg)
+	assert.Equal(t, int64(1), reorg.Depth)
+	assert.Equal(t, h2.Hash(), reorg.CommonAncestor.Hash)
+	assert.Equal(t, h3.Hash(), reorg.OldHead.Hash)
+	assert.Equal(t, h3b.Hash(), reorg.NewHead.Hash)
+	assert.Equal(t, []common.Hash{h3.Hash()}, reorg.RemovedHashes())
+	assert.Equal(t, h3b.Hash(), ht.Head().Hash)
+
+	// A longer chain forking from the first head, traced back through the node
+	h2c := eth.BlockHeader{Number: cltest.BigHexInt(2), ParityHash: cltest.NewHash(), ParentHash: h1.Hash()}
+	h3c := eth.BlockHeader{Number: cltest.BigHexInt(3), ParityHash: cltest.NewHash(), ParentHash: h2c.Hash()}
+	h4c := eth.BlockHeader{Number: cltest.BigHexInt(4), ParityHash: cltest.NewHash(), ParentHash: h3c.Hash()}
+	mocketh.Register("eth_getBlockByNumber", h3c)
+	mocketh.Register("eth_getBlockByNumber", h2c)
+	headers <- h4c
+	g.Eventually(func() int32 { return checker.OnReorgCount() }).Should(gomega.Equal(int32(2)))
+	g.Eventually(func() int32 { return checker.OnNewHeadCount() }).Should(gomega.Equal(int32(5)))
+	mocketh.EventuallyAllCalled(t)
+
+	reorg = reorgValue.Load().(*models.Reorg)
+	assert.Equal(t, int64(2), reorg.Depth)
+	assert.Equal(t, h1.Hash(), reorg.CommonAncestor.Hash)
+	assert.Equal(t, []common.Hash{h3b.Hash(), h2.Hash()}, reorg.RemovedHashes())
+
+	lastHead, err := store.LastHead()
+	require.NoError(t, err)
+	assert.Equal(t, h4c.Hash(), lastHead.Hash)
+	assert.Equal(t, h3c.Hash(), lastHead.ParentHash)
+	heads, err := store.LastHeads(10)
+	require.NoError(t, err)
+	assert.Len(t, heads, 2)
+}
+
+func TestHeadTracker_Save_FollowsGapsAndSkipsSeen(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	txManager := new(mocks.TxManager)
+	txManager.On("GetChainID").Maybe().Return(store.Config.ChainID(), nil)
+	txManager.On("SubscribeToNewHeads", mock.Anything).Maybe().Return(cltest.EmptyMockSubscription(), nil)
+	store.TxManager = txManager
+
+	h1 := cltest.Head(1)
+	h2 := cltest.Head(2)
+	h2.ParentHash = h1.Hash
+	require.NoError(t, store.CreateHead(h1))
+	require.NoError(t, store.CreateHead(h2))
+
+	ht := services.NewHeadTracker(store, []strpkg.HeadTrackable{})
+	require.NoError(t, ht.Start())
+	defer ht.Stop()
+
+	// Follows the current head after a missed block
+	h3 := cltest.Head(3)
+	h3.ParentHash = h2.Hash
+	h4 := cltest.Head(4)
+	h4.ParentHash = h3.Hash
+	txManager.On("GetBlockByNumber", "0x3").Return(eth.BlockHeader{ParityHash: h3.Hash, ParentHash: h2.Hash}, nil)
+	require.NoError(t, ht.Save(h4))
+	assert.Equal(t, h4.Hash, ht.Head().Hash)
+
+	// Seen before
+	assert.Error(t, ht.Save(h2))
+	assert.Equal(t, h4.Hash, ht.Head().Hash)
+
+	heads, err := store.LastHeads(10)
+	require.NoError(t, err)
+	assert.Len(t, heads, 3)
+	txManager.AssertExpectations(t)
+}
+
+func TestHeadTracker_Save_TracesChainWithoutLock(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	txManager := new(mocks.TxManager)
+	txManager.On("GetChainID").Maybe().Return(store.Config.ChainID(), nil)
+	txManager.On("SubscribeToNewHeads", mock.Anything).Maybe().Return(cltest.EmptyMockSubscription(), nil)
+	store.TxManager = txManager
+
+	h1 := cltest.Head(1)
+	h2 := cltest.Head(2)
+	h2.ParentHash = h1.Hash
+	require.NoError(t, store.CreateHead(h1))
+	require.NoError(t, store.CreateHead(h2))
+
+	ht := services.NewHeadTracker(store, []strpkg.HeadTrackable{})
+	require.NoError(t, ht.Start())
+	defer ht.Stop()
+
+	h3 := cltest.Head(3)
+	h3.ParentHash = h2.Hash
+	h4 := cltest.Head(4)
+	h4.ParentHash = h3.Hash
+
+	tracing := make(chan struct{})
+	proceed := make(chan struct{})
+	txManager.On("GetBlockByNumber", "0x3").
+		Run(func(mock.Arguments) {
+			close(tracing)
+			<-proceed
+		}).
+		Return(eth.BlockHeader{ParityHash: h3.Hash, ParentHash: h2.Hash}, nil)
+
+	saved := make(chan error)
+	go func() { saved <- ht.Save(h4) }()
+
+	<-tracing
+	assert.Equal(t, h2.Hash, ht.Head().Hash)
+	close(proceed)
+
+	require.NoError(t, <-saved)
+	assert.Equal(t, h4.Hash, ht.Head().Hash)
+	txManager.AssertExpectations(t)
+}
+
+func TestHeadTracker_Save_DoesNotTraceDistantHead(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	txManager := new(mocks.TxManager)
+	txManager.On("GetChainID").Maybe().Return(store.Config.ChainID(), nil)
+	txManager.On("SubscribeToNewHeads", mock.Anything).Maybe().Return(cltest.EmptyMockSubscription(), nil)
+	store.TxManager = txManager
+
+	h1 := cltest.Head(1)
+	h2 := cltest.Head(2)
+	h2.ParentHash = h1.Hash
+	require.NoError(t, store.CreateHead(h1))
+	require.NoError(t, store.CreateHead(h2))
+
+	ht := services.NewHeadTracker(store, []strpkg.HeadTrackable{})
+	require.NoError(t, ht.Start())
+	defer ht.Stop()
+
+	// Too far ahead to trace, so no blocks are requested from the node
+	distant := cltest.Head(1000)
+	distant.ParentHash = cltest.NewHash()
+	require.NoError(t, ht.Save(distant))
+	assert.Equal(t, distant.Hash, ht.Head().Hash)
+
+	// The window starts afresh from the distant head
+	next := cltest.Head(1001)
+	next.ParentHash = distant.Hash
+	require.NoError(t, ht.Save(next))
+	assert.Equal(t, next.Hash, ht.Head().Hash)
+	txManager.AssertNotCalled(t, "GetBlockByNumber", mock.Anything)
+}
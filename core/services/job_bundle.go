@@ -0,0 +1,297 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"chainlink/core/store"
+	"chainlink/core/store/models"
+	"chainlink/core/store/orm"
+	"chainlink/core/utils"
+
+	"github.com/pkg/errors"
+)
+
+// JobBundleConflictError is returned when a JobBundle can't be imported
+// because some of its jobs or bridges differ from those already on the node.
+type JobBundleConflictError struct {
+	Conflicts []string
+}
+
+func (e *JobBundleConflictError) Error() string {
+	return "job bundle conflicts with the node: " + strings.Join(e.Conflicts, "; ")
+}
+
+// ExportJobBundle bundles the jobs with the given IDs, or every job when none
+// are given, with the bridges, external initiators and service agreements
+// they depend on. Jobs created by a service agreement are exported as the
+// agreement.
+func ExportJobBundle(store *store.Store, ids []*models.ID) (models.JobBundle, error) {
+	var jobs []models.JobSpec
+	if len(ids) == 0 {
+		err := store.Jobs(func(j *models.JobSpec) bool {
+			jobs = append(jobs, *j)
+			return true
+		})
+		if err != nil {
+			return models.JobBundle{}, err
+		}
+	}
+	for _, id := range ids {
+		job, err := store.FindJob(id)
+		if err != nil {
+			return models.JobBundle{}, errors.Wrapf(err, "finding job %s", id)
+		}
+		jobs = append(jobs, job)
+	}
+
+	bundle := models.JobBundle{
+		Jobs:               []models.BundledJob{},
+		Bridges:            []models.BridgeTypeRequest{},
+		ExternalInitiators: []string{},
+		ServiceAgreements:  []models.JSON{},
+	}
+	bridges := map[models.TaskType]bool{}
+	externalInitiators := map[string]bool{}
+	for _, job := range jobs {
+		var sas []models.ServiceAgreement
+		if err := store.Where("job_spec_id", job.ID, &sas); err != nil {
+			return models.JobBundle{}, err
+		}
+		if len(sas) > 0 {
+			request, err := models.ParseJSON([]byte(sas[0].RequestBody))
+			if err != nil {
+				return models.JobBundle{}, errors.Wrapf(err, "parsing service agreement %s", sas[0].ID)
+			}
+			bundle.ServiceAgreements = append(bundle.ServiceAgreements, request)
+		} else {
+			bundle.Jobs = append(bundle.Jobs, models.NewBundledJob(job))
+		}
+
+		for _, task := range job.Tasks {
+			if bridges[task.Type] {
+				continue
+			}
+			bt, err := store.FindBridge(task.Type)
+			if errors.Cause(err) == orm.ErrorNotFound {
+				continue
+			} else if err != nil {
+				return models.JobBundle{}, err
+			}
+			bridges[task.Type] = true
+			bundle.Bridges = append(bundle.Bridges, models.NewBundledBridgeType(bt))
+		}
+		for _, initr := range job.InitiatorsFor(models.InitiatorExternal) {
+			externalInitiators[initr.Name] = true
+		}
+	}
+
+	for name := range externalInitiators {
+		bundle.ExternalInitiators = append(bundle.ExternalInitiators, name)
+	}
+	sort.Strings(bundle.ExternalInitiators)
+	sort.Slice(bundle.Bridges, func(i, j int) bool {
+		return bundle.Bridges[i].Name < bundle.Bridges[j].Name
+	})
+	return bundle, nil
+}
+
+// ImportJobBundle adds the jobs, bridges and service agreements of the
+// bundle that the node doesn't have yet. Those it already has, as they are
+// in the bundle, are left unchanged, so importing a bundle again does
+// nothing.
+//
+// Nothing is imported when any part of the bundle conflicts with the node,
+// which returns a JobBundleConflictError, or fails validation, which returns
+// the validation errors. Everything is validated before anything is saved,
+// and then saved in a single transaction.
+//
+// Once the bundle is valid, notify is called with each new job, so that its
+// external initiators can be told about it, as when a job is created. Jobs
+// that are saved but fail to start are reported with their errors, rather
+// than failing the import.
+func ImportJobBundle(app Application, bundle models.JobBundle, notify func(models.JobSpec) error) (models.JobBundleImport, error) {
+	store := app.GetStore()
+	report := models.JobBundleImport{
+		Jobs:              []models.BundleImportResult{},
+		Bridges:           []models.BundleImportResult{},
+		ServiceAgreements: []models.BundleImportResult{},
+	}
+	conflicts := &JobBundleConflictError{}
+	fe := models.NewJSONAPIErrors()
+
+	var newBridges []models.BridgeTypeRequest
+	for _, btr := range bundle.Bridges {
+		btr.RotateSigningSecret = false
+		if btr.Signed == nil {
+			unsigned := false
+			btr.Signed = &unsigned
+		}
+		existing, err := store.FindBridge(btr.Name)
+		if errors.Cause(err) == orm.ErrorNotFound {
+			if err := ValidateBridgeType(&btr, store); err != nil {
+				fe.Add(fmt.Sprintf("bridge %s: %v", btr.Name, err))
+			}
+			newBridges = append(newBridges, btr)
+			continue
+		} else if err != nil {
+			return report, err
+		}
+
+		if same, err := sameJSON(models.NewBundledBridgeType(existing), btr); err != nil {
+			return report, err
+		} else if !same {
+			conflicts.Conflicts = append(conflicts.Conflicts, fmt.Sprintf("bridge %s differs from the node's bridge of the same name", btr.Name))
+		} else {
+			report.Bridges = append(report.Bridges, models.BundleImportResult{ID: btr.Name.String(), Status: models.BundleImportUnchanged})
+		}
+	}
+
+	for _, name := range bundle.ExternalInitiators {
+		if _, err := store.FindExternalInitiatorByName(name); errors.Cause(err) == orm.ErrorNotFound {
+			fe.Add(fmt.Sprintf("external initiator %s does not exist on this node", name))
+		} else if err != nil {
+			return report, err
+		}
+	}
+
+	var newJobs []models.JobSpec
+	for _, bj := range bundle.Jobs {
+		if bj.ID == nil {
+			fe.Add("bundled jobs must have an ID")
+			continue
+		}
+		existing, err := store.Unscoped().FindJob(bj.ID)
+		if errors.Cause(err) == orm.ErrorNotFound {
+			newJobs = append(newJobs, bj.JobSpec())
+			continue
+		} else if err != nil {
+			return report, err
+		}
+
+		if existing.Archived() {
+			conflicts.Conflicts = append(conflicts.Conflicts, fmt.Sprintf("job %s has been archived", bj.ID))
+		} else if same, err := sameJSON(models.NewBundledJob(existing), models.NewBundledJob(bj.JobSpec())); err != nil {
+			return report, err
+		} else if !same {
+			conflicts.Conflicts = append(conflicts.Conflicts, fmt.Sprintf("job %s differs from the node's job with the same ID", bj.ID))
+		} else {
+			report.Jobs = append(report.Jobs, models.BundleImportResult{ID: bj.ID.String(), Status: models.BundleImportUnchanged})
+		}
+	}
+
+	var newSAs []models.ServiceAgreement
+	for _, request := range bundle.ServiceAgreements {
+		us, err := models.NewUnsignedServiceAgreementFromRequest(strings.NewReader(request.String()))
+		if err != nil {
+			fe.Add(fmt.Sprintf("service agreement: %v", err))
+			continue
+		}
+		if _, err := store.FindServiceAgreement(us.ID.String()); err == nil {
+			report.ServiceAgreements = append(report.ServiceAgreements, models.BundleImportResult{ID: us.ID.String(), Status: models.BundleImportUnchanged})
+			continue
+		} else if errors.Cause(err) != orm.ErrorNotFound {
+			return report, err
+		}
+
+		if !store.Config.Dev() {
+			fe.Add(fmt.Sprintf("service agreement %s: Service Agreements are currently under development and not yet usable outside of development mode", us.ID.String()))
+			continue
+		}
+		sa, err := models.BuildServiceAgreement(us, store.KeyStore)
+		if err != nil {
+			return report, err
+		}
+		newSAs = append(newSAs, sa)
+	}
+
+	if len(conflicts.Conflicts) > 0 {
+		return report, conflicts
+	}
+	if err := fe.CoerceEmptyToNil(); err != nil {
+		return report, err
+	}
+
+	// Jobs are validated against the bundle's new bridges as well as the
+	// node's, since the new ones are only saved along with the jobs.
+	var bridges []models.BridgeType
+	var bridgeReports []models.BundleImportResult
+	pending := map[models.TaskType]models.BridgeType{}
+	for i := range newBridges {
+		bta, bt, err := models.NewBridgeType(&newBridges[i])
+		if err != nil {
+			return report, err
+		}
+		bridges = append(bridges, *bt)
+		pending[bt.Name] = *bt
+		bridgeReports = append(bridgeReports, models.BundleImportResult{ID: bt.Name.String(), Status: models.BundleImportCreated, Bridge: bta})
+	}
+
+	for _, job := range newJobs {
+		if err := validateJob(job, store, pending); err != nil {
+			fe.Add(fmt.Sprintf("job %s: %v", job.ID, err))
+		}
+	}
+	for _, sa := range newSAs {
+		if err := validateServiceAgreement(sa, store, pending); err != nil {
+			fe.Add(fmt.Sprintf("service agreement %s: %v", sa.ID, err))
+		}
+	}
+	if err := fe.CoerceEmptyToNil(); err != nil {
+		return report, err
+	}
+
+	for _, job := range newJobs {
+		if err := notify(job); err != nil {
+			return report, errors.Wrapf(err, "notifying external initiators of job %s", job.ID)
+		}
+	}
+
+	startErrors, err := app.AddJobBundle(bridges, newJobs, newSAs)
+	if err != nil {
+		return report, errors.Wrap(err, "adding job bundle")
+	}
+
+	report.Bridges = append(report.Bridges, bridgeReports...)
+	for _, job := range newJobs {
+		report.Jobs = append(report.Jobs, createdResult(job.ID.String(), startErrors[job.ID.String()]))
+	}
+	for _, sa := range newSAs {
+		report.ServiceAgreements = append(report.ServiceAgreements, createdResult(sa.ID, startErrors[sa.JobSpec.ID.String()]))
+	}
+	return report, nil
+}
+
+// createdResult reports an item of the bundle that was added to the node,
+// along with the error its job failed to start with, if any.
+func createdResult(id string, startErr error) models.BundleImportResult {
+	result := models.BundleImportResult{ID: id, Status: models.BundleImportCreated}
+	if startErr != nil {
+		result.Error = startErr.Error()
+	}
+	return result
+}
+
+// sameJSON returns true if a and b serialize to the same JSON, regardless of
+// the order of keys.
+func sameJSON(a, b interface{}) (bool, error) {
+	aJSON, err := json.Marshal(a)
+	if err != nil {
+		return false, err
+	}
+	bJSON, err := json.Marshal(b)
+	if err != nil {
+		return false, err
+	}
+	aNormalized, err := utils.NormalizedJSON(aJSON)
+	if err != nil {
+		return false, err
+	}
+	bNormalized, err := utils.NormalizedJSON(bJSON)
+	if err != nil {
+		return false, err
+	}
+	return aNormalized == bNormalized, nil
+}
@@ -0,0 +1,252 @@
+package services_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"chainlink/core/internal/cltest"
+	"chainlink/core/internal/mocks"
+	"chainlink/core/services"
+	"chainlink/core/store/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobBundle_ExportImport(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	store := app.Store
+
+	_, bt := cltest.NewBridgeType(t, "bundledbridge")
+	require.NoError(t, store.CreateBridgeType(bt))
+	job := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{cltest.NewTask(t, "bundledbridge"), cltest.NewTask(t, "noop")}
+	require.NoError(t, app.AddJob(job))
+	other := cltest.NewJobWithWebInitiator()
+	require.NoError(t, app.AddJob(other))
+
+	bundle, err := services.ExportJobBundle(store, []*models.ID{job.ID})
+	require.NoError(t, err)
+	require.Len(t, bundle.Jobs, 1)
+	assert.Equal(t, job.ID, bundle.Jobs[0].ID)
+	require.Len(t, bundle.Bridges, 1)
+	assert.Equal(t, bt.Name, bundle.Bridges[0].Name)
+	assert.Equal(t, bt.URL, bundle.Bridges[0].URL)
+
+	all, err := services.ExportJobBundle(store, nil)
+	require.NoError(t, err)
+	assert.Len(t, all.Jobs, 2)
+
+	serialized, err := json.Marshal(bundle)
+	require.NoError(t, err)
+	var imported models.JobBundle
+	require.NoError(t, json.Unmarshal(serialized, &imported))
+
+	report, err := services.ImportJobBundle(app, imported, noNotify)
+	require.NoError(t, err)
+	assert.Equal(t, []models.BundleImportResult{{ID: job.ID.String(), Status: models.BundleImportUnchanged}}, report.Jobs)
+	assert.Equal(t, []models.BundleImportResult{{ID: "bundledbridge", Status: models.BundleImportUnchanged}}, report.Bridges)
+	assert.Len(t, cltest.AllJobs(t, store), 2)
+}
+
+func TestImportJobBundle_Create(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	store := app.Store
+
+	id := models.NewID()
+	bundle := bundleFromJSON(t, fmt.Sprintf(`{
+		"jobs": [{"id": "%s", "initiators": [{"type": "web"}], "tasks": [{"type": "importedbridge"}, {"type": "noop"}]}],
+		"bridges": [{"name": "importedbridge", "url": "https://example.com/adapter", "confirmations": 2}]
+	}`, id))
+
+	report, err := services.ImportJobBundle(app, bundle, noNotify)
+	require.NoError(t, err)
+	assert.Equal(t, []models.BundleImportResult{{ID: id.String(), Status: models.BundleImportCreated}}, report.Jobs)
+	require.Len(t, report.Bridges, 1)
+	assert.Equal(t, models.BundleImportCreated, report.Bridges[0].Status)
+	require.NotNil(t, report.Bridges[0].Bridge)
+	assert.NotEmpty(t, report.Bridges[0].Bridge.IncomingToken)
+	assert.NotEmpty(t, report.Bridges[0].Bridge.OutgoingToken)
+
+	job, err := store.FindJob(id)
+	require.NoError(t, err)
+	require.Len(t, job.Tasks, 2)
+	assert.Equal(t, models.MustNewTaskType("importedbridge"), job.Tasks[0].Type)
+	bt, err := store.FindBridge("importedbridge")
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), bt.Confirmations)
+
+	report, err = services.ImportJobBundle(app, bundle, noNotify)
+	require.NoError(t, err)
+	assert.Equal(t, models.BundleImportUnchanged, report.Jobs[0].Status)
+	assert.Equal(t, models.BundleImportUnchanged, report.Bridges[0].Status)
+	assert.Len(t, cltest.AllJobs(t, store), 1)
+}
+
+func TestImportJobBundle_Conflicts(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	store := app.Store
+
+	_, bt := cltest.NewBridgeType(t, "existingbridge", "https://example.com/existing")
+	require.NoError(t, store.CreateBridgeType(bt))
+	job := cltest.NewJobWithWebInitiator()
+	require.NoError(t, app.AddJob(job))
+
+	newID := models.NewID()
+	bundle := bundleFromJSON(t, fmt.Sprintf(`{
+		"jobs": [
+			{"id": "%s", "initiators": [{"type": "web"}], "tasks": [{"type": "noop"}, {"type": "noop"}]},
+			{"id": "%s", "initiators": [{"type": "web"}], "tasks": [{"type": "noop"}]}
+		],
+		"bridges": [{"name": "existingbridge", "url": "https://example.com/moved"}]
+	}`, job.ID, newID))
+
+	_, err := services.ImportJobBundle(app, bundle, noNotify)
+	require.Error(t, err)
+	conflicts, ok := err.(*services.JobBundleConflictError)
+	require.True(t, ok, "expected a JobBundleConflictError, got %v", err)
+	assert.Len(t, conflicts.Conflicts, 2)
+
+	_, err = store.FindJob(newID)
+	assert.Error(t, err, "nothing should be imported from a conflicting bundle")
+}
+
+func TestImportJobBundle_Invalid(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	store := app.Store
+
+	bundle := bundleFromJSON(t, fmt.Sprintf(`{
+		"jobs": [{"id": "%s", "initiators": [{"type": "web"}], "tasks": [{"type": "newbridge"}, {"type": "idonotexist"}]}],
+		"bridges": [{"name": "newbridge", "url": "https://example.com/adapter"}],
+		"externalInitiators": ["nosuchinitiator"]
+	}`, models.NewID()))
+
+	_, err := services.ImportJobBundle(app, bundle, noNotify)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "nosuchinitiator")
+
+	bundle.ExternalInitiators = nil
+	_, err = services.ImportJobBundle(app, bundle, noNotify)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "idonotexist")
+
+	_, err = store.FindBridge("newbridge")
+	assert.Error(t, err, "no bridges should be created when the bundle's jobs are invalid")
+	assert.Len(t, cltest.AllJobs(t, store), 0)
+}
+
+func TestImportJobBundle_Atomic(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	store := app.Store
+
+	id := models.NewID()
+	bundle := bundleFromJSON(t, fmt.Sprintf(`{
+		"jobs": [
+			{"id": "%s", "initiators": [{"type": "web"}], "tasks": [{"type": "newbridge"}]},
+			{"id": "%s", "initiators": [{"type": "web"}], "tasks": [{"type": "noop"}]}
+		],
+		"bridges": [{"name": "newbridge", "url": "https://example.com/adapter"}]
+	}`, id, id))
+
+	_, err := services.ImportJobBundle(app, bundle, noNotify)
+	require.Error(t, err)
+
+	_, err = store.FindBridge("newbridge")
+	assert.Error(t, err, "nothing should be saved when saving part of the bundle fails")
+	assert.Len(t, cltest.AllJobs(t, store), 0)
+}
+
+func TestImportJobBundle_NotifiesNewJobs(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	store := app.Store
+
+	existing, id := models.NewID(), models.NewID()
+	bundle := bundleFromJSON(t, fmt.Sprintf(`{
+		"jobs": [
+			{"id": "%s", "initiators": [{"type": "web"}], "tasks": [{"type": "noop"}]},
+			{"id": "%s", "initiators": [{"type": "web"}], "tasks": [{"type": "noop"}]}
+		]
+	}`, existing, id))
+	_, err := services.ImportJobBundle(app, models.JobBundle{Jobs: bundle.Jobs[:1]}, noNotify)
+	require.NoError(t, err)
+
+	_, err = services.ImportJobBundle(app, bundle, func(models.JobSpec) error {
+		return errors.New("initiator unreachable")
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "initiator unreachable")
+	assert.Len(t, cltest.AllJobs(t, store), 1, "nothing should be saved when notifying fails")
+
+	var notified []*models.ID
+	_, err = services.ImportJobBundle(app, bundle, func(js models.JobSpec) error {
+		notified = append(notified, js.ID)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*models.ID{id}, notified)
+}
+
+func TestImportJobBundle_StartFailure(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	store := app.Store
+
+	jobSubscriber := new(mocks.JobSubscriber)
+	jobSubscriber.On("AddJob", mock.Anything, (*models.Head)(nil)).Return(errors.New("subscription failed"))
+	app.ChainlinkApplication.JobSubscriber = jobSubscriber
+
+	id := models.NewID()
+	bundle := bundleFromJSON(t, fmt.Sprintf(`{
+		"jobs": [{"id": "%s", "initiators": [{"type": "web"}], "tasks": [{"type": "noop"}]}]
+	}`, id))
+
+	report, err := services.ImportJobBundle(app, bundle, noNotify)
+	require.NoError(t, err)
+	require.Len(t, report.Jobs, 1)
+	assert.Equal(t, models.BundleImportCreated, report.Jobs[0].Status)
+	assert.Equal(t, "subscription failed", report.Jobs[0].Error)
+
+	_, err = store.FindJob(id)
+	assert.NoError(t, err, "the job should be saved even though it failed to start")
+	jobSubscriber.AssertExpectations(t)
+}
+
+func noNotify(models.JobSpec) error {
+	return nil
+}
+
+func bundleFromJSON(t *testing.T, s string) models.JobBundle {
+	var bundle models.JobBundle
+	require.NoError(t, json.Unmarshal([]byte(s), &bundle))
+	return bundle
+}
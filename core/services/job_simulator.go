@@ -0,0 +1,216 @@
+package services
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"chainlink/core/adapters"
+	"chainlink/core/assets"
+	"chainlink/core/eth"
+	strpkg "chainlink/core/store"
+	"chainlink/core/store/models"
+	"chainlink/core/utils"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	null "gopkg.in/guregu/null.v3"
+)
+
+// TaskSimulation is the outcome of simulating a single task of a job.
+type TaskSimulation struct {
+	TaskID string           `json:"taskId,omitempty"`
+	Type   models.TaskType  `json:"type"`
+	Status models.RunStatus `json:"status"`
+	Result models.JSON      `json:"result"`
+	Error  string           `json:"error,omitempty"`
+	// Stubbed is set when the adapter's side effects were not carried out,
+	// because the task would call a bridge or send a transaction.
+	Stubbed       bool          `json:"stubbed"`
+	BridgeRequest *models.JSON  `json:"bridgeRequest,omitempty"`
+	Transactions  []SimulatedTx `json:"transactions,omitempty"`
+}
+
+// SimulatedTx is an Ethereum transaction that a task would have sent.
+type SimulatedTx struct {
+	Hash     common.Hash    `json:"hash"`
+	To       common.Address `json:"to"`
+	Data     hexutil.Bytes  `json:"data"`
+	Value    *utils.Big     `json:"value,omitempty"`
+	GasPrice *utils.Big     `json:"gasPrice,omitempty"`
+	GasLimit uint64         `json:"gasLimit,omitempty"`
+}
+
+// SimulateJob runs the tasks of a job with the given input, returning the
+// output of every task without saving a run. Bridges are not called, and
+// Ethereum transactions are captured rather than sent.
+//
+// Tasks that would wait on confirmations, a bridge or a sleep report that
+// status, and the tasks after them carry on with their data. Tasks after one
+// that errors are not run, and are reported as unstarted. Tasks whose
+// condition is not met are reported as skipped.
+func SimulateJob(ctx context.Context, store *strpkg.Store, job models.JobSpec, input models.JSON) []TaskSimulation {
+	txm := &simulatedTxManager{TxManager: store.TxManager}
+	simulations := make([]TaskSimulation, len(job.Tasks))
+	var current *TaskSimulation
+
+	je := &runExecutor{
+		store: &strpkg.Store{
+			ORM:         store.ORM,
+			Config:      store.Config,
+			Clock:       store.Clock,
+			KeyStore:    store.KeyStore,
+			TxManager:   txm,
+			StatsPusher: store.StatsPusher,
+			HTTPClients: store.HTTPClients,
+		},
+		perform: func(ctx context.Context, adapter adapters.BaseAdapter, input models.RunInput, store *strpkg.Store) models.RunOutput {
+			if pa, ok := adapter.(*adapters.PipelineAdapter); ok {
+				if bridge, ok := pa.BaseAdapter.(*adapters.Bridge); ok {
+					return simulateBridge(bridge, input, current)
+				}
+			}
+			return adapters.Perform(ctx, adapter, input, store)
+		},
+	}
+
+	run := job.NewRun(models.Initiator{Type: models.InitiatorWeb})
+	run.Overrides = input
+	for i, tr := range run.TaskRuns {
+		simulations[i] = TaskSimulation{
+			TaskID: tr.TaskSpec.TaskID,
+			Type:   tr.TaskSpec.Type,
+			Status: models.RunStatusUnstarted,
+		}
+	}
+
+	for {
+		ready := run.ReadyTaskRunIndexes()
+		if len(ready) == 0 {
+			break
+		}
+
+		index := ready[0]
+		taskRun := &run.TaskRuns[index]
+		current = &simulations[index]
+		if run.SkipsTaskRun(index) {
+			run.SkipTaskRun(index)
+			current.Status = models.RunStatusSkipped
+			continue
+		}
+
+		output := je.executeTask(ctx, &run, taskRun)
+		current.Transactions = txm.flush()
+		if len(current.Transactions) > 0 {
+			current.Stubbed = true
+		}
+		if output.HasError() {
+			taskRun.ApplyOutput(output)
+			current.Status = models.RunStatusErrored
+			current.Error = output.Error().Error()
+			continue
+		}
+
+		current.Status = output.Status()
+		current.Result = output.Data()
+		if !output.Status().Completed() {
+			previous, err := taskRunInput(&run, taskRun)
+			if err != nil {
+				output = models.NewRunOutputError(err)
+			} else if data, err := models.Merge(run.Overrides, previous, output.Data()); err != nil {
+				output = models.NewRunOutputError(err)
+			} else {
+				output = models.NewRunOutputComplete(data)
+			}
+		}
+		taskRun.ApplyOutput(output)
+	}
+
+	return simulations
+}
+
+// simulateBridge records the request a bridge would have been sent, passing
+// the task's input through in place of the external adapter's response.
+func simulateBridge(bridge *adapters.Bridge, input models.RunInput, simulation *TaskSimulation) models.RunOutput {
+	request, err := models.Merge(input.Data(), bridge.Params)
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	simulation.Stubbed = true
+	simulation.BridgeRequest = &request
+	return models.NewRunOutputComplete(input.Data())
+}
+
+// simulatedTxManager captures the transactions created through it instead of
+// signing and sending them, and reports them as sent but unconfirmed.
+type simulatedTxManager struct {
+	strpkg.TxManager
+
+	mutex sync.Mutex
+	txs   []SimulatedTx
+}
+
+func (txm *simulatedTxManager) flush() []SimulatedTx {
+	txm.mutex.Lock()
+	defer txm.mutex.Unlock()
+	txs := txm.txs
+	txm.txs = nil
+	return txs
+}
+
+func (txm *simulatedTxManager) capture(to common.Address, data []byte, value *big.Int, gasPrice *big.Int, gasLimit uint64) *models.Tx {
+	hash := crypto.Keccak256Hash(to.Bytes(), data)
+	txm.mutex.Lock()
+	txm.txs = append(txm.txs, SimulatedTx{
+		Hash:     hash,
+		To:       to,
+		Data:     data,
+		Value:    utils.NewBig(value),
+		GasPrice: utils.NewBig(gasPrice),
+		GasLimit: gasLimit,
+	})
+	txm.mutex.Unlock()
+
+	tx := &models.Tx{
+		To:       to,
+		Data:     data,
+		Value:    utils.NewBig(value),
+		GasLimit: gasLimit,
+		Hash:     hash,
+		GasPrice: utils.NewBig(gasPrice),
+	}
+	tx.Attempts = []*models.TxAttempt{{Hash: hash, GasPrice: tx.GasPrice}}
+	return tx
+}
+
+// Connected always returns true, so that transactions are captured while the
+// node is disconnected from Ethereum.
+func (txm *simulatedTxManager) Connected() bool {
+	return true
+}
+
+// CreateTx captures a transaction with the default gas settings.
+func (txm *simulatedTxManager) CreateTx(to common.Address, data []byte) (*models.Tx, error) {
+	return txm.capture(to, data, nil, nil, 0), nil
+}
+
+// CreateTxWithGas captures a transaction with the given gas settings.
+func (txm *simulatedTxManager) CreateTxWithGas(surrogateID null.String, to common.Address, data []byte, gasPriceWei *big.Int, gasLimit uint64) (*models.Tx, error) {
+	return txm.capture(to, data, nil, gasPriceWei, gasLimit), nil
+}
+
+// CreateTxWithEth captures a transfer of ETH.
+func (txm *simulatedTxManager) CreateTxWithEth(from, to common.Address, value *assets.Eth) (*models.Tx, error) {
+	return txm.capture(to, nil, value.ToInt(), nil, 0), nil
+}
+
+// CheckAttempt reports a captured transaction as unconfirmed.
+func (txm *simulatedTxManager) CheckAttempt(txAttempt *models.TxAttempt, blockHeight uint64) (*eth.TxReceipt, strpkg.AttemptState, error) {
+	return &eth.TxReceipt{Hash: txAttempt.Hash}, strpkg.Unconfirmed, nil
+}
+
+// BumpGasUntilSafe reports a captured transaction as unconfirmed.
+func (txm *simulatedTxManager) BumpGasUntilSafe(hash common.Hash) (*eth.TxReceipt, strpkg.AttemptState, error) {
+	return &eth.TxReceipt{Hash: hash}, strpkg.Unconfirmed, nil
+}
@@ -0,0 +1,124 @@
+package services_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"chainlink/core/internal/cltest"
+	"chainlink/core/services"
+	"chainlink/core/store/models"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulateJob(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	bridgeCalled := false
+	bridgeServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bridgeCalled = true
+	}))
+	defer bridgeServer.Close()
+	_, bt := cltest.NewBridgeType(t, "simulatedbridge", bridgeServer.URL)
+	require.NoError(t, store.CreateBridgeType(bt))
+
+	to := "0x356a04bce728ba4c62a30294a55e6a8600a320b3"
+	j := models.NewJob()
+	j.Initiators = []models.Initiator{{Type: models.InitiatorWeb}}
+	j.Tasks = []models.TaskSpec{
+		cltest.NewTask(t, "noop"),
+		cltest.NewTask(t, "simulatedbridge", `{"extra":"param"}`),
+		cltest.NewTask(t, "ethuint256"),
+		cltest.NewTask(t, "ethtx", `{"address":"`+to+`","functionSelector":"0x12345678"}`),
+		cltest.NewTask(t, "noop"),
+	}
+	input := cltest.JSONFromString(t, `{"result":"16"}`)
+
+	simulations := services.SimulateJob(context.Background(), store, j, input)
+
+	require.Len(t, simulations, 5)
+	assert.False(t, bridgeCalled)
+
+	assert.Equal(t, models.RunStatusCompleted, simulations[0].Status)
+	assert.False(t, simulations[0].Stubbed)
+
+	assert.Equal(t, models.RunStatusCompleted, simulations[1].Status)
+	assert.True(t, simulations[1].Stubbed)
+	require.NotNil(t, simulations[1].BridgeRequest)
+	assert.Equal(t, "param", simulations[1].BridgeRequest.Get("extra").String())
+	assert.Equal(t, "16", simulations[1].Result.Get("result").String())
+
+	ethTx := simulations[3]
+	assert.Equal(t, models.RunStatusPendingConfirmations, ethTx.Status)
+	assert.True(t, ethTx.Stubbed)
+	require.Len(t, ethTx.Transactions, 1)
+	assert.Equal(t, common.HexToAddress(to), ethTx.Transactions[0].To)
+	assert.Equal(t,
+		"0x123456780000000000000000000000000000000000000000000000000000000000000010",
+		ethTx.Transactions[0].Data.String())
+	assert.Equal(t, ethTx.Transactions[0].Hash.Hex(), ethTx.Result.Get("result").String())
+
+	assert.Equal(t, models.RunStatusCompleted, simulations[4].Status)
+
+	count, err := store.ORM.CountOf(&models.JobRun{})
+	require.NoError(t, err)
+	assert.Zero(t, count)
+	count, err = store.ORM.CountOf(&models.Tx{})
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+func TestSimulateJob_Error(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "price feed down", http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	j := models.NewJob()
+	j.Initiators = []models.Initiator{{Type: models.InitiatorWeb}}
+	j.Tasks = []models.TaskSpec{
+		cltest.NewTask(t, "httpget", `{"get":"`+server.URL+`"}`),
+		cltest.NewTask(t, "noop"),
+	}
+
+	simulations := services.SimulateJob(context.Background(), store, j, models.JSON{})
+
+	require.Len(t, simulations, 2)
+	assert.Equal(t, models.RunStatusErrored, simulations[0].Status)
+	assert.Contains(t, simulations[0].Error, "price feed down")
+	assert.Equal(t, models.RunStatusUnstarted, simulations[1].Status)
+}
+
+func TestSimulateJob_Condition(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	j := models.NewJob()
+	j.Initiators = []models.Initiator{{Type: models.InitiatorWeb}}
+	j.Tasks = []models.TaskSpec{
+		cltest.NewTask(t, "compare", `{"operator": "gt", "value": "5"}`),
+		cltest.NewTask(t, "noop"),
+	}
+	j.Tasks[0].TaskID = "moved"
+	j.Tasks[1].Condition = "moved"
+
+	simulations := services.SimulateJob(context.Background(), store, j, cltest.JSONFromString(t, `{"result": "2"}`))
+
+	require.Len(t, simulations, 2)
+	assert.Equal(t, models.RunStatusCompleted, simulations[0].Status)
+	assert.Equal(t, models.RunStatusSkipped, simulations[1].Status)
+}
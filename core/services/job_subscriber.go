@@ -41,7 +41,9 @@ func NewJobSubscriber(store *store.Store, runManager RunManager) JobSubscriber {
 }
 
 // AddJob subscribes to ethereum log events for each "runlog" and "ethlog"
-// initiator in the passed job spec.
+// initiator in the passed job spec. The subscription of an earlier version of
+// the job is only unsubscribed once the new one has started, so that no logs
+// are missed in between.
 func (js *jobSubscriber) AddJob(job models.JobSpec, bn *models.Head) error {
 	if !job.IsLogInitiated() {
 		return nil
@@ -51,7 +53,9 @@ func (js *jobSubscriber) AddJob(job models.JobSpec, bn *models.Head) error {
 	if err != nil {
 		return err
 	}
-	js.addSubscription(sub)
+	if previous, ok := js.addSubscription(sub); ok {
+		previous.Unsubscribe()
+	}
 	return nil
 }
 
@@ -81,11 +85,15 @@ func (js *jobSubscriber) Jobs() []models.JobSpec {
 	return jobs
 }
 
-func (js *jobSubscriber) addSubscription(sub JobSubscription) {
+// addSubscription records the subscription, returning the one it replaces
+// if any.
+func (js *jobSubscriber) addSubscription(sub JobSubscription) (JobSubscription, bool) {
 	js.jobsMutex.Lock()
 	defer js.jobsMutex.Unlock()
 
+	previous, ok := js.jobSubscriptions[sub.Job.ID.String()]
 	js.jobSubscriptions[sub.Job.ID.String()] = sub
+	return previous, ok
 }
 
 // Connect connects the jobs to the ethereum node by creating corresponding subscriptions.
@@ -117,3 +125,14 @@ func (js *jobSubscriber) OnNewHead(head *models.Head) {
 		logger.Errorw("Failed to resume confirming tasks on new head", "error", err)
 	}
 }
+
+// OnReorg cancels the unfinished runs requested by logs in blocks that are no
+// longer on the chain.
+func (js *jobSubscriber) OnReorg(reorg *models.Reorg) {
+	runIDs, err := js.store.UnfinishedJobRunIDsInBlocks(reorg.RemovedHashes())
+	if err != nil {
+		logger.Errorw("Failed to find runs requested in reorged blocks", "error", err)
+		return
+	}
+	cancelReorgedRuns(js.runManager, runIDs)
+}
@@ -30,6 +30,42 @@ func TestJobSubscriber_OnNewHead(t *testing.T) {
 	runManager.AssertExpectations(t)
 }
 
+func TestJobSubscriber_OnReorg(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithLogInitiator()
+	require.NoError(t, store.CreateJob(&job))
+
+	removed := cltest.Head(10)
+	run := job.NewRun(job.Initiators[0])
+	run.Status = models.RunStatusPendingConfirmations
+	run.RunRequest.BlockHash = &removed.Hash
+	require.NoError(t, store.CreateJobRun(&run))
+
+	kept := job.NewRun(job.Initiators[0])
+	kept.Status = models.RunStatusPendingConfirmations
+	keptHash := cltest.NewHash()
+	kept.RunRequest.BlockHash = &keptHash
+	require.NoError(t, store.CreateJobRun(&kept))
+
+	runManager := new(mocks.RunManager)
+	jobSubscriber := services.NewJobSubscriber(store, runManager)
+
+	runManager.On("Cancel", run.ID).Return(&run, nil)
+
+	jobSubscriber.OnReorg(&models.Reorg{
+		Depth:   1,
+		OldHead: removed,
+		NewHead: cltest.Head(10),
+		Removed: []models.Head{*removed},
+	})
+
+	runManager.AssertExpectations(t)
+}
+
 func TestJobSubscriber_AddJob_RemoveJob(t *testing.T) {
 	t.Parallel()
 
@@ -55,6 +91,29 @@ func TestJobSubscriber_AddJob_RemoveJob(t *testing.T) {
 
 }
 
+func TestJobSubscriber_AddJob_ReplacesPreviousVersion(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	eth := cltest.MockEthOnStore(t, store)
+	previousLogs := make(chan ethpkg.Log)
+	eth.RegisterSubscription("logs", previousLogs)
+	eth.RegisterSubscription("logs")
+
+	runManager := new(mocks.RunManager)
+	jobSubscriber := services.NewJobSubscriber(store, runManager)
+
+	jobSpec := cltest.NewJobWithLogInitiator()
+	require.NoError(t, jobSubscriber.AddJob(jobSpec, cltest.Head(321)))
+	require.NoError(t, jobSubscriber.AddJob(jobSpec, cltest.Head(322)))
+
+	assert.Len(t, jobSubscriber.Jobs(), 1)
+	_, open := <-previousLogs
+	assert.False(t, open, "previous version should have unsubscribed")
+	eth.EventuallyAllCalled(t)
+}
+
 func TestJobSubscriber_AddJob_NotLogInitiatedError(t *testing.T) {
 	t.Parallel()
 
@@ -1,10 +1,12 @@
 package services
 
 import (
+	"sync"
 	"time"
 
 	"chainlink/core/logger"
 	"chainlink/core/store"
+	"chainlink/core/store/models"
 	"chainlink/core/store/orm"
 )
 
@@ -28,3 +30,89 @@ func (sr *storeReaper) Work() {
 		logger.Error("unable to reap stale sessions: ", err)
 	}
 }
+
+// RunReaperInterval is how often the RunReaper deletes the runs that have
+// outlived the retention period.
+const RunReaperInterval = time.Hour
+
+// RunReaper deletes completed runs once they have outlived the configured
+// retention period. It reaps when started, and every RunReaperInterval after
+// that.
+type RunReaper struct {
+	SleeperTask
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+type runReaper struct {
+	store  *store.Store
+	config orm.ConfigReader
+}
+
+// NewRunReaper creates a reaper that deletes runs past the retention period.
+func NewRunReaper(store *store.Store) *RunReaper {
+	return &RunReaper{
+		SleeperTask: NewSleeperTask(&runReaper{
+			store:  store,
+			config: store.Config,
+		}),
+		done: make(chan struct{}),
+	}
+}
+
+// Start reaps runs now and then periodically, until Stop is called.
+func (rr *RunReaper) Start() error {
+	if err := rr.SleeperTask.Start(); err != nil {
+		return err
+	}
+	rr.WakeUp()
+
+	rr.wg.Add(1)
+	go rr.wakeLoop()
+	return nil
+}
+
+// Stop stops reaping runs.
+func (rr *RunReaper) Stop() error {
+	close(rr.done)
+	rr.wg.Wait()
+	return rr.SleeperTask.Stop()
+}
+
+func (rr *RunReaper) wakeLoop() {
+	defer rr.wg.Done()
+
+	ticker := time.NewTicker(RunReaperInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			rr.WakeUp()
+		case <-rr.done:
+			return
+		}
+	}
+}
+
+func (rr *runReaper) Work() {
+	period := rr.config.RunRetentionPeriod()
+	if period == 0 {
+		return
+	}
+
+	batchSize := bulkDeleteBatchSize(rr.config)
+	query := &models.BulkDeleteRunRequest{
+		Status:        models.RunStatusCollection{models.RunStatusCompleted},
+		UpdatedBefore: time.Now().Add(-period),
+	}
+	for {
+		deleted, err := rr.store.BulkDeleteRunsBatch(query, batchSize)
+		if err != nil {
+			logger.Error("unable to reap runs past the retention period: ", err)
+			return
+		}
+		if deleted < batchSize {
+			return
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"chainlink/core/adapters"
 	"chainlink/core/internal/cltest"
 	"chainlink/core/services"
 	"chainlink/core/store/models"
@@ -60,3 +61,61 @@ func TestStoreReaper_ReapSessions(t *testing.T) {
 		})
 	}
 }
+
+func TestRunReaper_ReapsRunsPastRetentionPeriod(t *testing.T) {
+	t.Parallel()
+
+	config, cfgCleanup := cltest.NewConfig(t)
+	defer cfgCleanup()
+	config.Set("RUN_RETENTION_PERIOD", 24*time.Hour)
+	store, cleanup := cltest.NewStoreWithConfig(config)
+	defer cleanup()
+
+	job := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{{Type: adapters.TaskTypeNoOp}}
+	require.NoError(t, store.CreateJob(&job))
+
+	expired := time.Now().Add(-25 * time.Hour)
+	reaped := createRunUpdatedAt(t, store, job, models.RunStatusCompleted, expired)
+	kept := []models.JobRun{
+		createRunUpdatedAt(t, store, job, models.RunStatusErrored, expired),
+		createRunUpdatedAt(t, store, job, models.RunStatusPendingSleep, expired),
+		createRunUpdatedAt(t, store, job, models.RunStatusCompleted, time.Now().Add(-23*time.Hour)),
+	}
+
+	r := services.NewRunReaper(store)
+	require.NoError(t, r.Start())
+	defer r.Stop()
+
+	gomega.NewGomegaWithT(t).Eventually(func() error {
+		_, err := store.FindJobRun(reaped.ID)
+		return err
+	}).Should(gomega.HaveOccurred())
+
+	for _, run := range kept {
+		_, err := store.FindJobRun(run.ID)
+		assert.NoError(t, err)
+	}
+}
+
+func TestRunReaper_KeepsRunsWithoutRetentionPeriod(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{{Type: adapters.TaskTypeNoOp}}
+	require.NoError(t, store.CreateJob(&job))
+	run := createRunUpdatedAt(t, store, job, models.RunStatusCompleted, time.Now().Add(-24*365*time.Hour))
+
+	r := services.NewRunReaper(store)
+	require.NoError(t, r.Start())
+	defer r.Stop()
+	r.WakeUp()
+
+	gomega.NewGomegaWithT(t).Consistently(func() error {
+		_, err := store.FindJobRun(run.ID)
+		return err
+	}).ShouldNot(gomega.HaveOccurred())
+}
@@ -1,7 +1,10 @@
 package services
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"sync"
 	"time"
 
 	"chainlink/core/adapters"
@@ -11,33 +14,94 @@ import (
 	"chainlink/core/store/orm"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 )
 
+var (
+	promTaskDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "task_duration_seconds",
+		Help: "The time taken to perform a task, by adapter type",
+	}, []string{"adapter"})
+)
+
+// specOnlyParams are the task params that only the job spec can set. The
+// overrides of a run, which hold the data of requests such as RunLogs, cannot
+// add them, since a requester choosing the gas price could spend the node's
+// ETH.
+var specOnlyParams = []string{"gasPrice"}
+
 //go:generate mockery -name RunExecutor -output ../internal/mocks/ -case=underscore
 
 // RunExecutor handles the actual running of the job tasks
 type RunExecutor interface {
 	Execute(*models.ID) error
+	Cancel(*models.ID)
 }
 
 type runExecutor struct {
 	store *store.Store
+	// perform runs an adapter, and is adapters.Perform except when simulating
+	perform func(context.Context, adapters.BaseAdapter, models.RunInput, *store.Store) models.RunOutput
+
+	cancelsMutex sync.Mutex
+	cancels      map[string]context.CancelFunc
 }
 
 // NewRunExecutor initializes a RunExecutor.
 func NewRunExecutor(store *store.Store) RunExecutor {
 	return &runExecutor{
-		store: store,
+		store:   store,
+		perform: adapters.Perform,
+		cancels: make(map[string]context.CancelFunc),
+	}
+}
+
+// Cancel stops the tasks of a run that is currently executing. The run's
+// status is left to the caller, so any task output is discarded.
+func (je *runExecutor) Cancel(runID *models.ID) {
+	je.cancelsMutex.Lock()
+	defer je.cancelsMutex.Unlock()
+	if cancel, ok := je.cancels[runID.String()]; ok {
+		cancel()
+	}
+}
+
+func (je *runExecutor) runContext(runID *models.ID) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	je.cancelsMutex.Lock()
+	je.cancels[runID.String()] = cancel
+	je.cancelsMutex.Unlock()
+
+	return ctx, func() {
+		je.cancelsMutex.Lock()
+		delete(je.cancels, runID.String())
+		je.cancelsMutex.Unlock()
+		cancel()
 	}
 }
 
 // Execute performs the work associate with a job run
 func (je *runExecutor) Execute(runID *models.ID) error {
+	// Registered before loading the run so that a cancellation saved after
+	// the run is loaded still reaches its tasks.
+	ctx, done := je.runContext(runID)
+	defer done()
+
 	run, err := je.store.Unscoped().FindJobRun(runID)
 	if err != nil {
 		return errors.Wrapf(err, "error finding run %s", runID)
 	}
 
+	if run.Status.Cancelled() {
+		logger.Debugw("Run cancelled before execution", run.ForLogger()...)
+		return nil
+	}
+
+	if run.HasTaskGraph() {
+		return je.executeGraph(ctx, &run)
+	}
+
 	for taskIndex := range run.TaskRuns {
 		taskRun := &run.TaskRuns[taskIndex]
 		if !run.Status.Runnable() {
@@ -45,14 +109,21 @@ func (je *runExecutor) Execute(runID *models.ID) error {
 			break
 		}
 
-		if taskRun.Status.Completed() {
+		if taskRun.Status.Completed() || taskRun.Status.Skipped() {
 			continue
 		}
 
-		if meetsMinimumConfirmations(&run, taskRun, run.ObservedHeight) {
+		if run.SkipsTaskRun(taskIndex) {
+			logger.Debugw(fmt.Sprintf("Skipped task %s", taskRun.TaskSpec.Type), run.ForLogger("task", taskRun.ID.String())...)
+			run.SkipTaskRun(taskIndex)
+		} else if meetsMinimumConfirmations(&run, taskRun, run.ObservedHeight) {
 			start := time.Now()
 
-			result := je.executeTask(&run, taskRun)
+			result := je.executeTask(ctx, &run, taskRun)
+			if ctx.Err() != nil {
+				logger.Debugw("Run cancelled during execution", run.ForLogger("task", taskRun.ID.String())...)
+				return nil
+			}
 
 			taskRun.ApplyOutput(result)
 			run.ApplyOutput(result)
@@ -84,10 +155,96 @@ func (je *runExecutor) Execute(runID *models.ID) error {
 	return nil
 }
 
-func (je *runExecutor) executeTask(run *models.JobRun, taskRun *models.TaskRun) models.RunOutput {
+// executeGraph runs the tasks of a task graph in waves, executing every task
+// whose inputs have completed concurrently, until none are left that can be
+// executed without waiting on confirmations, a bridge or a sleep. Tasks whose
+// condition is not met are skipped instead.
+func (je *runExecutor) executeGraph(ctx context.Context, run *models.JobRun) error {
+	attempted := map[int]bool{}
+	for run.Status.Runnable() {
+		wave := []int{}
+		skipped := false
+		for _, index := range run.ReadyTaskRunIndexes() {
+			if attempted[index] {
+				continue
+			}
+			attempted[index] = true
+			if run.SkipsTaskRun(index) {
+				logger.Debugw(fmt.Sprintf("Skipped task %s", run.TaskRuns[index].TaskSpec.Type), run.ForLogger("task", run.TaskRuns[index].ID.String())...)
+				run.SkipTaskRun(index)
+				skipped = true
+				continue
+			}
+			wave = append(wave, index)
+		}
+		if len(wave) == 0 {
+			if skipped {
+				// Skipping a task may have made the tasks after it ready.
+				continue
+			}
+			break
+		}
+
+		outputs := make([]models.RunOutput, len(wave))
+		var wg sync.WaitGroup
+		for i, index := range wave {
+			taskRun := &run.TaskRuns[index]
+			if !meetsMinimumConfirmations(run, taskRun, run.ObservedHeight) {
+				logger.Debugw("Pausing task pending confirmations",
+					run.ForLogger("task", taskRun.ID.String(), "required_height", taskRun.MinimumConfirmations)...,
+				)
+				outputs[i] = models.NewRunOutputPendingConfirmations()
+				continue
+			}
+
+			wg.Add(1)
+			go func(i, index int) {
+				defer wg.Done()
+				taskRun := &run.TaskRuns[index]
+				start := time.Now()
+				outputs[i] = je.executeTask(ctx, run, taskRun)
+				elapsed := time.Since(start).Seconds()
+				logger.Debugw(fmt.Sprintf("Executed task %s", taskRun.TaskSpec.Type), run.ForLogger("task", taskRun.ID.String(), "elapsed", elapsed)...)
+			}(i, index)
+		}
+		wg.Wait()
+		if ctx.Err() != nil {
+			logger.Debugw("Run cancelled during execution", run.ForLogger()...)
+			return nil
+		}
+
+		run.ApplyGraphOutputs(wave, outputs)
+		run.SettleGraphStatus()
+		if err := je.store.ORM.SaveJobRun(run); errors.Cause(err) == orm.OptimisticUpdateConflictError {
+			logger.Debugw("Optimistic update conflict while updating run", run.ForLogger()...)
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+
+	run.SettleGraphStatus()
+	if err := je.store.ORM.SaveJobRun(run); errors.Cause(err) == orm.OptimisticUpdateConflictError {
+		logger.Debugw("Optimistic update conflict while updating run", run.ForLogger()...)
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if run.Status.Finished() {
+		logger.Debugw("All tasks complete for run", run.ForLogger()...)
+	}
+	return nil
+}
+
+func (je *runExecutor) executeTask(ctx context.Context, run *models.JobRun, taskRun *models.TaskRun) models.RunOutput {
 	taskCopy := taskRun.TaskSpec // deliberately copied to keep mutations local
 
-	params, err := models.Merge(run.Overrides, taskCopy.Params)
+	overrides, err := withoutSpecOnlyParams(run.Overrides)
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	params, err := models.Merge(overrides, taskCopy.Params)
 	if err != nil {
 		return models.NewRunOutputError(err)
 	}
@@ -98,11 +255,9 @@ func (je *runExecutor) executeTask(run *models.JobRun, taskRun *models.TaskRun)
 		return models.NewRunOutputError(err)
 	}
 
-	previousTaskRun := run.PreviousTaskRun()
-
-	previousTaskInput := models.JSON{}
-	if previousTaskRun != nil {
-		previousTaskInput = previousTaskRun.Result.Data
+	previousTaskInput, err := taskRunInput(run, taskRun)
+	if err != nil {
+		return models.NewRunOutputError(err)
 	}
 
 	data, err := models.Merge(run.Overrides, previousTaskInput, taskRun.Result.Data)
@@ -110,7 +265,80 @@ func (je *runExecutor) executeTask(run *models.JobRun, taskRun *models.TaskRun)
 		return models.NewRunOutputError(err)
 	}
 
-	input := *models.NewRunInput(run.ID, data, taskRun.Status)
-	result := adapter.Perform(input, je.store)
+	timeout := taskCopy.Timeout.Duration()
+	if timeout == 0 {
+		timeout = je.store.Config.DefaultTaskTimeout()
+	}
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	input := *models.NewRunInputForTaskRun(run.ID, taskRun.ID, data, taskRun.Status)
+	start := time.Now()
+	result := je.perform(ctx, adapter, input, je.store)
+	promTaskDuration.WithLabelValues(taskCopy.Type.String()).Observe(time.Since(start).Seconds())
+	if result.HasError() && ctx.Err() == context.DeadlineExceeded {
+		return models.NewRunOutputError(adapters.TimeoutError{Timeout: timeout})
+	}
 	return result
 }
+
+// withoutSpecOnlyParams returns the overrides without any specOnlyParams.
+func withoutSpecOnlyParams(overrides models.JSON) (models.JSON, error) {
+	var err error
+	for _, key := range specOnlyParams {
+		if overrides.Get(key).Exists() {
+			if overrides, err = overrides.Delete(key); err != nil {
+				return overrides, err
+			}
+		}
+	}
+	return overrides, nil
+}
+
+// taskRunInput returns the data a TaskRun receives from the tasks before it.
+// A task with a single input receives that task's data unchanged, while a
+// task joining several inputs receives their results as an array under
+// "result", and each input's data keyed by its TaskID under "inputs".
+func taskRunInput(run *models.JobRun, taskRun *models.TaskRun) (models.JSON, error) {
+	var index int
+	for i := range run.TaskRuns {
+		if &run.TaskRuns[i] == taskRun {
+			index = i
+			break
+		}
+	}
+
+	inputs := run.TaskRunInputs(index)
+	switch len(inputs) {
+	case 0:
+		return models.JSON{}, nil
+	case 1:
+		return inputs[0].Result.Data, nil
+	}
+
+	results := make([]json.RawMessage, len(inputs))
+	byTaskID := map[string]json.RawMessage{}
+	for i, input := range inputs {
+		results[i] = json.RawMessage("null")
+		if result := input.Result.Data.Get("result"); result.Exists() {
+			results[i] = json.RawMessage(result.Raw)
+		}
+		data, err := input.Result.Data.MarshalJSON()
+		if err != nil {
+			return models.JSON{}, err
+		}
+		byTaskID[input.TaskSpec.TaskID] = data
+	}
+
+	b, err := json.Marshal(map[string]interface{}{
+		"result": results,
+		"inputs": byTaskID,
+	})
+	if err != nil {
+		return models.JSON{}, err
+	}
+	return models.ParseJSON(b)
+}
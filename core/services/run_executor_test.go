@@ -3,12 +3,15 @@ package services_test
 import (
 	"fmt"
 	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"strconv"
 	"testing"
 	"time"
 
 	"chainlink/core/adapters"
 	"chainlink/core/assets"
+	"chainlink/core/eth"
 	"chainlink/core/internal/cltest"
 	"chainlink/core/internal/mocks"
 	"chainlink/core/null"
@@ -17,6 +20,7 @@ import (
 	"chainlink/core/utils"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -102,13 +106,22 @@ func TestRunExecutor_Execute_RunNotFoundError(t *testing.T) {
 	require.Error(t, err)
 }
 
+// newHangingServer returns a server that only responds once the client gives
+// up on the request.
+func newHangingServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+}
+
 func TestRunExecutor_Execute_CancelActivelyRunningTask(t *testing.T) {
 	t.Parallel()
 
 	store, cleanup := cltest.NewStore(t)
 	defer cleanup()
-	clock := cltest.NewTriggerClock(t)
-	store.Clock = clock
+
+	server := newHangingServer()
+	defer server.Close()
 
 	runExecutor := services.NewRunExecutor(store)
 
@@ -116,7 +129,7 @@ func TestRunExecutor_Execute_CancelActivelyRunningTask(t *testing.T) {
 	i := models.Initiator{Type: models.InitiatorWeb}
 	j.Initiators = []models.Initiator{i}
 	j.Tasks = []models.TaskSpec{
-		cltest.NewTask(t, "sleep", `{"until": 2147483647}`),
+		cltest.NewTask(t, "httpget", fmt.Sprintf(`{"get": "%s"}`, server.URL)),
 		cltest.NewTask(t, "noop"),
 	}
 	assert.NoError(t, store.CreateJob(&j))
@@ -125,22 +138,29 @@ func TestRunExecutor_Execute_CancelActivelyRunningTask(t *testing.T) {
 	run.Payment = assets.NewLink(19238)
 	require.NoError(t, store.CreateJobRun(&run))
 
+	executed := make(chan struct{})
 	go func() {
-		err := runExecutor.Execute(run.ID)
-		require.NoError(t, err)
+		assert.NoError(t, runExecutor.Execute(run.ID))
+		close(executed)
 	}()
 
 	// FIXME: Can't think of a better way to do this
-	// Make sure Execute has some time to start the sleep task
+	// Make sure Execute has some time to start the request
 	time.Sleep(300 * time.Millisecond)
 
-	runQueue := new(mocks.RunQueue)
-	runManager := services.NewRunManager(runQueue, store.Config, store.ORM, store.TxManager, clock)
-	runManager.Cancel(run.ID)
+	runQueue := services.NewRunQueue(runExecutor)
+	runManager := services.NewRunManager(runQueue, store.Config, store.ORM, store.TxManager, store.Clock)
+	_, err := runManager.Cancel(run.ID)
+	require.NoError(t, err)
 
-	clock.Trigger()
+	// The server never responds, so Execute only returns if cancelled
+	select {
+	case <-executed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected cancellation to stop the running task")
+	}
 
-	run, err := store.FindJobRun(run.ID)
+	run, err = store.FindJobRun(run.ID)
 	require.NoError(t, err)
 	assert.Equal(t, models.RunStatusCancelled, run.Status)
 
@@ -153,6 +173,115 @@ func TestRunExecutor_Execute_CancelActivelyRunningTask(t *testing.T) {
 	assert.Nil(t, actual)
 }
 
+func TestRunExecutor_Execute_IgnoresRequestedGasPrice(t *testing.T) {
+	t.Parallel()
+
+	config, cfgCleanup := cltest.NewConfig(t)
+	defer cfgCleanup()
+	config.Set("CHAINLINK_DEV", false)
+	app, cleanup := cltest.NewApplicationWithConfigAndKey(t, config)
+	defer cleanup()
+	store := app.Store
+
+	ethMock, err := app.MockStartAndConnect()
+	require.NoError(t, err)
+	ethMock.Register("eth_sendRawTransaction", cltest.NewHash(),
+		func(_ interface{}, data ...interface{}) error {
+			rlp := data[0].([]interface{})[0].(string)
+			tx, err := utils.DecodeEthereumTx(rlp)
+			require.NoError(t, err)
+			assert.Equal(t, config.EthGasPriceDefault(), tx.GasPrice())
+			return nil
+		})
+	ethMock.Register("eth_getTransactionReceipt", eth.TxReceipt{})
+
+	j := cltest.NewJobWithRunLogInitiator()
+	j.Tasks = []models.TaskSpec{cltest.NewTask(t, "ethtx", fmt.Sprintf(`{"address":"%s","functionSelector":"0x12345678"}`, cltest.NewAddress().Hex()))}
+	require.NoError(t, store.CreateJob(&j))
+
+	run := j.NewRun(j.Initiators[0])
+	run.Overrides = cltest.JSONFromString(t, `{"gasPrice":"1000000000000"}`)
+	require.NoError(t, store.CreateJobRun(&run))
+
+	require.NoError(t, services.NewRunExecutor(store).Execute(run.ID))
+	ethMock.EventuallyAllCalled(t)
+
+	txs, err := store.TxFrom(cltest.GetAccountAddress(t, store))
+	require.NoError(t, err)
+	require.Len(t, txs, 1)
+	require.Len(t, txs[0].Attempts, 1)
+	assert.Equal(t, utils.NewBig(config.EthGasPriceDefault()), txs[0].Attempts[0].GasPrice)
+}
+
+func TestRunExecutor_Execute_UsesJobSpecGasPrice(t *testing.T) {
+	t.Parallel()
+
+	config, cfgCleanup := cltest.NewConfig(t)
+	defer cfgCleanup()
+	config.Set("CHAINLINK_DEV", false)
+	app, cleanup := cltest.NewApplicationWithConfigAndKey(t, config)
+	defer cleanup()
+	store := app.Store
+
+	jobGasPrice := big.NewInt(30000000000)
+	ethMock, err := app.MockStartAndConnect()
+	require.NoError(t, err)
+	ethMock.Register("eth_sendRawTransaction", cltest.NewHash(),
+		func(_ interface{}, data ...interface{}) error {
+			rlp := data[0].([]interface{})[0].(string)
+			tx, err := utils.DecodeEthereumTx(rlp)
+			require.NoError(t, err)
+			assert.Equal(t, jobGasPrice, tx.GasPrice())
+			return nil
+		})
+	ethMock.Register("eth_getTransactionReceipt", eth.TxReceipt{})
+
+	j := cltest.NewJobWithRunLogInitiator()
+	j.Tasks = []models.TaskSpec{cltest.NewTask(t, "ethtx", fmt.Sprintf(`{"address":"%s","functionSelector":"0x12345678","gasPrice":"%s"}`, cltest.NewAddress().Hex(), jobGasPrice))}
+	require.NoError(t, store.CreateJob(&j))
+
+	run := j.NewRun(j.Initiators[0])
+	run.Overrides = cltest.JSONFromString(t, `{"gasPrice":"1000000000000"}`)
+	require.NoError(t, store.CreateJobRun(&run))
+
+	require.NoError(t, services.NewRunExecutor(store).Execute(run.ID))
+	ethMock.EventuallyAllCalled(t)
+
+	txs, err := store.TxFrom(cltest.GetAccountAddress(t, store))
+	require.NoError(t, err)
+	require.Len(t, txs, 1)
+	require.Len(t, txs[0].Attempts, 1)
+	assert.Equal(t, utils.NewBig(jobGasPrice), txs[0].Attempts[0].GasPrice)
+}
+
+func TestRunExecutor_Execute_Sleep(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	runExecutor := services.NewRunExecutor(store)
+
+	j := cltest.NewJobWithWebInitiator()
+	j.Tasks = []models.TaskSpec{
+		cltest.NewTask(t, "sleep", `{"until": 2147483647}`),
+		cltest.NewTask(t, "noop"),
+	}
+	require.NoError(t, store.CreateJob(&j))
+
+	run := j.NewRun(j.Initiators[0])
+	require.NoError(t, store.CreateJobRun(&run))
+
+	require.NoError(t, runExecutor.Execute(run.ID))
+
+	run, err := store.FindJobRun(run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusPendingSleep, run.Status)
+	assert.Equal(t, models.RunStatusPendingSleep, run.TaskRuns[0].Status)
+	assert.Equal(t, int64(2147483647), run.TaskRuns[0].WakeAt.Time.Unix())
+	assert.Equal(t, models.RunStatusUnstarted, run.TaskRuns[1].Status)
+}
+
 func TestRunExecutor_InitialTaskLacksConfirmations(t *testing.T) {
 	t.Parallel()
 
@@ -206,3 +335,249 @@ func TestJobRunner_prioritizeSpecParamsOverRequestParams(t *testing.T) {
 	expected := strconv.FormatUint(uint64(requestBase*specParameter), 10)
 	assert.Equal(t, expected, actual)
 }
+
+func TestRunExecutor_Execute_TaskGraph(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	runExecutor := services.NewRunExecutor(store)
+
+	j := cltest.NewJobWithWebInitiator()
+	j.Tasks = []models.TaskSpec{
+		cltest.NewTask(t, "multiply", `{"times": 1}`),
+		cltest.NewTask(t, "multiply", `{"times": 10}`),
+		cltest.NewTask(t, "multiply", `{"times": 100}`),
+		cltest.NewTask(t, "noop"),
+	}
+	j.Tasks[0].TaskID = "a"
+	j.Tasks[1].TaskID = "b"
+	j.Tasks[2].TaskID = "c"
+	j.Tasks[3].Inputs = models.TaskInputs{"a", "b", "c"}
+	require.NoError(t, store.CreateJob(&j))
+
+	run := j.NewRun(j.Initiators[0])
+	run.Overrides = cltest.JSONFromString(t, `{"result": 2}`)
+	require.NoError(t, store.CreateJobRun(&run))
+
+	require.NoError(t, runExecutor.Execute(run.ID))
+
+	run, err := store.FindJobRun(run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusCompleted, run.Status)
+	for _, tr := range run.TaskRuns {
+		assert.Equal(t, models.RunStatusCompleted, tr.Status)
+	}
+	assert.JSONEq(t, `["2","20","200"]`, run.Result.Data.Get("result").Raw)
+}
+
+func TestRunExecutor_Execute_TaskGraphResumesPendingBridge(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	mockServer, ensureCalled := cltest.NewHTTPMockServer(t, http.StatusOK, "POST", `{"pending": true}`)
+	defer ensureCalled()
+	_, bt := cltest.NewBridgeType(t, "graphBridge", mockServer.URL)
+	require.NoError(t, store.CreateBridgeType(bt))
+
+	runExecutor := services.NewRunExecutor(store)
+	runQueue := new(mocks.RunQueue)
+	runQueue.On("Run", mock.Anything).Return(nil)
+	runManager := services.NewRunManager(runQueue, store.Config, store.ORM, store.TxManager, store.Clock)
+
+	j := cltest.NewJobWithWebInitiator()
+	j.Tasks = []models.TaskSpec{
+		cltest.NewTask(t, "noop"),
+		cltest.NewTask(t, "graphBridge"),
+		cltest.NewTask(t, "noop"),
+	}
+	j.Tasks[0].TaskID = "local"
+	j.Tasks[1].TaskID = "remote"
+	j.Tasks[2].Inputs = models.TaskInputs{"local", "remote"}
+	require.NoError(t, store.CreateJob(&j))
+
+	run := j.NewRun(j.Initiators[0])
+	run.Overrides = cltest.JSONFromString(t, `{"result": "7"}`)
+	require.NoError(t, store.CreateJobRun(&run))
+
+	require.NoError(t, runExecutor.Execute(run.ID))
+
+	run, err := store.FindJobRun(run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusPendingBridge, run.Status)
+	assert.Equal(t, models.RunStatusCompleted, run.TaskRuns[0].Status)
+	assert.Equal(t, models.RunStatusPendingBridge, run.TaskRuns[1].Status)
+	assert.Equal(t, models.RunStatusUnstarted, run.TaskRuns[2].Status)
+
+	brr := models.BridgeRunResult{
+		Data:      cltest.JSONFromString(t, `{"result": "9"}`),
+		Status:    models.RunStatusCompleted,
+		TaskRunID: run.TaskRuns[1].ID,
+	}
+	require.NoError(t, runManager.ResumePending(run.ID, brr))
+	require.NoError(t, runExecutor.Execute(run.ID))
+
+	run, err = store.FindJobRun(run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusCompleted, run.Status)
+	assert.JSONEq(t, `["7","9"]`, run.Result.Data.Get("result").Raw)
+}
+
+func TestRunExecutor_Execute_Condition(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		input        string
+		wantStatuses []models.RunStatus
+		wantResult   string
+	}{
+		{
+			"condition met",
+			`{"result": "7"}`,
+			[]models.RunStatus{models.RunStatusCompleted, models.RunStatusCompleted, models.RunStatusCompleted},
+			"true",
+		},
+		{
+			"condition not met ends the run",
+			`{"result": "2"}`,
+			[]models.RunStatus{models.RunStatusCompleted, models.RunStatusSkipped, models.RunStatusSkipped},
+			"false",
+		},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			store, cleanup := cltest.NewStore(t)
+			defer cleanup()
+
+			runExecutor := services.NewRunExecutor(store)
+
+			j := cltest.NewJobWithWebInitiator()
+			j.Tasks = []models.TaskSpec{
+				cltest.NewTask(t, "compare", `{"operator": "gt", "value": "5"}`),
+				cltest.NewTask(t, "noop"),
+				cltest.NewTask(t, "noop"),
+			}
+			j.Tasks[0].TaskID = "moved"
+			j.Tasks[1].Condition = "moved"
+			require.NoError(t, store.CreateJob(&j))
+
+			run := j.NewRun(j.Initiators[0])
+			run.Overrides = cltest.JSONFromString(t, test.input)
+			require.NoError(t, store.CreateJobRun(&run))
+
+			require.NoError(t, runExecutor.Execute(run.ID))
+
+			run, err := store.FindJobRun(run.ID)
+			require.NoError(t, err)
+			assert.Equal(t, models.RunStatusCompleted, run.Status)
+			assert.True(t, run.FinishedAt.Valid)
+			for i, tr := range run.TaskRuns {
+				assert.Equal(t, test.wantStatuses[i], tr.Status)
+			}
+			assert.Equal(t, test.wantResult, run.Result.Data.Get("result").String())
+		})
+	}
+}
+
+func TestRunExecutor_Execute_TaskGraphCondition(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	runExecutor := services.NewRunExecutor(store)
+
+	j := cltest.NewJobWithWebInitiator()
+	j.Tasks = []models.TaskSpec{
+		cltest.NewTask(t, "multiply", `{"times": 1}`),
+		cltest.NewTask(t, "compare", `{"operator": "gt", "value": "5"}`),
+		cltest.NewTask(t, "multiply", `{"times": 100}`),
+		cltest.NewTask(t, "noop"),
+		cltest.NewTask(t, "multiply", `{"times": 10}`),
+	}
+	j.Tasks[0].TaskID = "price"
+	j.Tasks[1].TaskID = "moved"
+	j.Tasks[1].Inputs = models.TaskInputs{"price"}
+	j.Tasks[2].TaskID = "scaled"
+	j.Tasks[2].Inputs = models.TaskInputs{"price"}
+	j.Tasks[2].Condition = "moved"
+	j.Tasks[3].Inputs = models.TaskInputs{"scaled"}
+	j.Tasks[4].Inputs = models.TaskInputs{"price"}
+	j.Tasks[4].Condition = "!moved"
+	require.NoError(t, store.CreateJob(&j))
+
+	run := j.NewRun(j.Initiators[0])
+	run.Overrides = cltest.JSONFromString(t, `{"result": "2"}`)
+	require.NoError(t, store.CreateJobRun(&run))
+
+	require.NoError(t, runExecutor.Execute(run.ID))
+
+	run, err := store.FindJobRun(run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusCompleted, run.Status)
+	assert.Equal(t, models.RunStatusCompleted, run.TaskRuns[0].Status)
+	assert.Equal(t, models.RunStatusCompleted, run.TaskRuns[1].Status)
+	assert.Equal(t, models.RunStatusSkipped, run.TaskRuns[2].Status)
+	assert.Equal(t, models.RunStatusSkipped, run.TaskRuns[3].Status)
+	assert.Equal(t, models.RunStatusCompleted, run.TaskRuns[4].Status)
+	assert.Equal(t, "20", run.Result.Data.Get("result").String())
+}
+
+func TestRunExecutor_Execute_TaskTimeout(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		specTimeout   time.Duration
+		configTimeout string
+		wantError     string
+	}{
+		{"spec timeout", 100 * time.Millisecond, "0s", "task timed out after 100ms"},
+		{"node default", 0, "150ms", "task timed out after 150ms"},
+		{"spec overrides node default", 100 * time.Millisecond, "1h", "task timed out after 100ms"},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			store, cleanup := cltest.NewStore(t)
+			defer cleanup()
+			store.Config.Set("DEFAULT_TASK_TIMEOUT", test.configTimeout)
+
+			server := newHangingServer()
+			defer server.Close()
+
+			runExecutor := services.NewRunExecutor(store)
+
+			j := cltest.NewJobWithWebInitiator()
+			j.Tasks = []models.TaskSpec{
+				cltest.NewTask(t, "httpget", fmt.Sprintf(`{"get": "%s"}`, server.URL)),
+				cltest.NewTask(t, "noop"),
+			}
+			j.Tasks[0].Timeout = models.Duration(test.specTimeout)
+			require.NoError(t, store.CreateJob(&j))
+
+			run := j.NewRun(j.Initiators[0])
+			require.NoError(t, store.CreateJobRun(&run))
+
+			require.NoError(t, runExecutor.Execute(run.ID))
+
+			run, err := store.FindJobRun(run.ID)
+			require.NoError(t, err)
+			assert.Equal(t, models.RunStatusErrored, run.Status)
+			assert.Equal(t, models.RunStatusErrored, run.TaskRuns[0].Status)
+			assert.Equal(t, test.wantError, run.TaskRuns[0].Result.ErrorMessage.String)
+			assert.Equal(t, models.RunStatusUnstarted, run.TaskRuns[1].Status)
+		})
+	}
+}
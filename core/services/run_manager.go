@@ -3,6 +3,7 @@ package services
 import (
 	"fmt"
 	"math/big"
+	"time"
 
 	"chainlink/core/adapters"
 	"chainlink/core/assets"
@@ -49,6 +50,7 @@ type RunManager interface {
 	ResumeAllInProgress() error
 	ResumeAllConfirming(currentBlockHeight *big.Int) error
 	ResumeAllConnecting() error
+	ResumeAllSleeping(now time.Time) error
 }
 
 // runManager implements RunManager
@@ -240,7 +242,7 @@ func (jm *runManager) Create(
 // waiting for block confirmations.
 func (jm *runManager) ResumeAllConfirming(currentBlockHeight *big.Int) error {
 	return jm.orm.UnscopedJobRunsWithStatus(func(run *models.JobRun) {
-		currentTaskRun := run.NextTaskRun()
+		currentTaskRun := run.NextPendingTaskRun(models.RunStatusPendingConfirmations, models.RunStatusPendingConnection)
 		if currentTaskRun == nil {
 			jm.updateWithError(run, "Attempting to resume confirming run with no remaining tasks %s", run.ID)
 			return
@@ -264,7 +266,7 @@ func (jm *runManager) ResumeAllConnecting() error {
 	return jm.orm.UnscopedJobRunsWithStatus(func(run *models.JobRun) {
 		logger.Debugw("New connection resuming run", run.ForLogger()...)
 
-		currentTaskRun := run.NextTaskRun()
+		currentTaskRun := run.NextPendingTaskRun(models.RunStatusPendingConnection)
 		if currentTaskRun == nil {
 			jm.updateWithError(run, "Attempting to resume connecting run with no remaining tasks %s", run.ID)
 			return
@@ -279,6 +281,21 @@ func (jm *runManager) ResumeAllConnecting() error {
 	}, models.RunStatusPendingConnection, models.RunStatusPendingConfirmations)
 }
 
+// ResumeAllSleeping wakes up all runs with a task sleeping until now or earlier.
+func (jm *runManager) ResumeAllSleeping(now time.Time) error {
+	return jm.orm.UnscopedJobRunsWithSleepingTasksDue(func(run *models.JobRun) {
+		if !run.WakeSleepingTaskRuns(now) {
+			return
+		}
+
+		logger.Debugw("Sleep finished, resuming run", run.ForLogger()...)
+		err := jm.updateAndTrigger(run)
+		if err != nil {
+			logger.Errorw("Error saving run", run.ForLogger("error", err)...)
+		}
+	}, now)
+}
+
 // ResumePendingTask wakes up a task that required a response from a bridge adapter.
 func (jm *runManager) ResumePending(
 	runID *models.ID,
@@ -291,20 +308,24 @@ func (jm *runManager) ResumePending(
 
 	logger.Debugw("External adapter resuming run", run.ForLogger("input_data", input.Data)...)
 
-	if !run.Status.PendingBridge() {
+	if !run.AwaitingBridge() {
 		return fmt.Errorf("Attempting to resume non pending run %s", run.ID)
 	}
 
-	currentTaskRun := run.NextTaskRun()
+	currentTaskRun := run.PendingBridgeTaskRun(input.TaskRunID)
 	if currentTaskRun == nil {
 		return jm.updateWithError(&run, "Attempting to resume pending run with no remaining tasks %s", run.ID)
 	}
 
-	data, err := models.Merge(run.Overrides, input.Data)
-	if err != nil {
-		return jm.updateWithError(&run, "Error while merging onto overrides for run %s", run.ID)
+	// Tasks in a graph take their input from their upstream tasks rather
+	// than the overrides, which are shared by every branch.
+	if !run.HasTaskGraph() {
+		data, err := models.Merge(run.Overrides, input.Data)
+		if err != nil {
+			return jm.updateWithError(&run, "Error while merging onto overrides for run %s", run.ID)
+		}
+		run.Overrides = data
 	}
-	run.Overrides = data
 
 	currentTaskRun.ApplyBridgeRunResult(input)
 	run.ApplyBridgeRunResult(input)
@@ -322,7 +343,7 @@ func (jm *runManager) ResumePending(
 // To recap: This must run before anything else writes job run status to the db,
 // ie. tries to run a job.
 func (jm *runManager) ResumeAllInProgress() error {
-	return jm.orm.UnscopedJobRunsWithStatus(jm.runQueue.Run, models.RunStatusInProgress, models.RunStatusPendingSleep)
+	return jm.orm.UnscopedJobRunsWithStatus(jm.runQueue.Run, models.RunStatusInProgress)
 }
 
 // Cancel suspends a running task.
@@ -338,7 +359,12 @@ func (jm *runManager) Cancel(runID *models.ID) (*models.JobRun, error) {
 	}
 
 	run.Cancel()
-	return &run, jm.orm.SaveJobRun(&run)
+	if err := jm.orm.SaveJobRun(&run); err != nil {
+		return nil, err
+	}
+
+	jm.runQueue.Cancel(run.ID)
+	return &run, nil
 }
 
 func (jm *runManager) updateWithError(run *models.JobRun, msg string, args ...interface{}) error {
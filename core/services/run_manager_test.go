@@ -501,7 +501,6 @@ func TestRunManager_ResumeAllInProgress(t *testing.T) {
 		status models.RunStatus
 	}{
 		{models.RunStatusInProgress},
-		{models.RunStatusPendingSleep},
 	}
 
 	for _, test := range tests {
@@ -535,7 +534,6 @@ func TestRunManager_ResumeAllInProgress_Archived(t *testing.T) {
 		status models.RunStatus
 	}{
 		{models.RunStatusInProgress},
-		{models.RunStatusPendingSleep},
 	}
 
 	for _, test := range tests {
@@ -634,3 +632,49 @@ func TestRunManager_ResumeAllInProgress_NotInProgressAndArchived(t *testing.T) {
 		})
 	}
 }
+
+func TestRunManager_ResumeAllSleeping(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	now := time.Now()
+	tests := []struct {
+		name       string
+		wakeAt     time.Time
+		wantStatus models.RunStatus
+	}{
+		{"due", now.Add(-time.Minute), models.RunStatusInProgress},
+		{"not due", now.Add(time.Minute), models.RunStatusPendingSleep},
+	}
+
+	runQueue := new(mocks.RunQueue)
+	runQueue.On("Run", mock.Anything).Return(nil).Once()
+
+	runs := make([]models.JobRun, len(tests))
+	for i, test := range tests {
+		job := cltest.NewJobWithWebInitiator()
+		job.Tasks = []models.TaskSpec{cltest.NewTask(t, "sleep")}
+		require.NoError(t, store.CreateJob(&job))
+
+		run := job.NewRun(job.Initiators[0])
+		run.Status = models.RunStatusPendingSleep
+		run.TaskRuns[0].Status = models.RunStatusPendingSleep
+		run.TaskRuns[0].WakeAt = null.TimeFrom(test.wakeAt)
+		require.NoError(t, store.CreateJobRun(&run))
+		runs[i] = run
+	}
+
+	runManager := services.NewRunManager(runQueue, store.Config, store.ORM, store.TxManager, store.Clock)
+	require.NoError(t, runManager.ResumeAllSleeping(now))
+
+	for i, test := range tests {
+		run, err := store.FindJobRun(runs[i].ID)
+		require.NoError(t, err, test.name)
+		assert.Equal(t, test.wantStatus, run.Status, test.name)
+		assert.Equal(t, test.wantStatus, run.TaskRuns[0].Status, test.name)
+	}
+
+	runQueue.AssertExpectations(t)
+}
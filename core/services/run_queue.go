@@ -7,6 +7,16 @@ import (
 
 	"chainlink/core/logger"
 	"chainlink/core/store/models"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	promNumberOfWorkers = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "run_queue_workers",
+		Help: "The number of workers currently processing runs",
+	})
 )
 
 //go:generate mockery -name RunQueue -output ../internal/mocks/ -case=underscore
@@ -16,6 +26,7 @@ type RunQueue interface {
 	Start() error
 	Stop()
 	Run(*models.JobRun)
+	Cancel(*models.ID)
 
 	WorkerCount() int
 }
@@ -81,6 +92,7 @@ func (rq *runQueue) Run(run *models.JobRun) {
 	}
 	rq.runsExecuted += 1
 	rq.workers[runID] = 1
+	promNumberOfWorkers.Set(float64(len(rq.workers)))
 	rq.workersMutex.Unlock()
 
 	rq.workersWg.Add(1)
@@ -90,6 +102,7 @@ func (rq *runQueue) Run(run *models.JobRun) {
 			queueCount := rq.workers[runID]
 			if queueCount <= 0 {
 				delete(rq.workers, runID)
+				promNumberOfWorkers.Set(float64(len(rq.workers)))
 				rq.workersMutex.Unlock()
 				break
 			}
@@ -105,6 +118,11 @@ func (rq *runQueue) Run(run *models.JobRun) {
 	}()
 }
 
+// Cancel stops the work of a run being executed by one of the workers
+func (rq *runQueue) Cancel(runID *models.ID) {
+	rq.runExecutor.Cancel(runID)
+}
+
 // WorkerCount returns the number of workers currently processing a job run
 func (rq *runQueue) WorkerCount() int {
 	rq.workersMutex.RLock()
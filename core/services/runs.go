@@ -49,6 +49,19 @@ func validateMinimumConfirmations(run *models.JobRun, taskRun *models.TaskRun, c
 	}
 }
 
+// cancelReorgedRuns cancels the runs requested by logs that were reorged out
+// of the chain. A log that is included again in the new chain starts a new
+// run, so the old runs must not carry on.
+func cancelReorgedRuns(runManager RunManager, runIDs []*models.ID) {
+	for _, runID := range runIDs {
+		if _, err := runManager.Cancel(runID); err != nil {
+			logger.Errorw("Failed to cancel run requested by a reorged log", "run", runID.String(), "error", err)
+			continue
+		}
+		logger.Infow("Cancelled run requested by a reorged log", "run", runID.String())
+	}
+}
+
 func validateOnMainChain(run *models.JobRun, taskRun *models.TaskRun, txManager store.TxManager) error {
 	txhash := run.RunRequest.TxHash
 	if txhash == nil || !taskRun.MinimumConfirmations.Valid || taskRun.MinimumConfirmations.Uint32 == 0 {
@@ -0,0 +1,80 @@
+package services
+
+import (
+	"sync"
+	"time"
+
+	"chainlink/core/logger"
+	"chainlink/core/store/orm"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var runsDesc = prometheus.NewDesc(
+	"job_runs",
+	"The number of runs of each job in each status",
+	[]string{"job_spec_id", "status"},
+	nil,
+)
+
+// runsCollectorCacheDuration is how long the run counts are reused for,
+// so that frequent scrapes don't each count every run in the database.
+const runsCollectorCacheDuration = time.Minute
+
+// RunsCollector is a prometheus.Collector reporting the number of runs of
+// each job in each status. The counts are read from the database, at most
+// once every runsCollectorCacheDuration, so they include runs from before
+// the node started.
+type RunsCollector struct {
+	orm       *orm.ORM
+	mutex     sync.Mutex
+	counts    []orm.JobRunStatusCount
+	countedAt time.Time
+}
+
+// NewRunsCollector returns a new RunsCollector.
+func NewRunsCollector(orm *orm.ORM) *RunsCollector {
+	return &RunsCollector{orm: orm}
+}
+
+// Describe implements prometheus.Collector.
+func (rc *RunsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- runsDesc
+}
+
+// Collect implements prometheus.Collector.
+func (rc *RunsCollector) Collect(ch chan<- prometheus.Metric) {
+	counts, err := rc.jobRunStatusCounts()
+	if err != nil {
+		logger.Errorw("Error counting runs for metrics", "error", err)
+		return
+	}
+
+	for _, count := range counts {
+		ch <- prometheus.MustNewConstMetric(
+			runsDesc,
+			prometheus.GaugeValue,
+			float64(count.Count),
+			count.JobSpecID.String(),
+			string(count.Status),
+		)
+	}
+}
+
+func (rc *RunsCollector) jobRunStatusCounts() ([]orm.JobRunStatusCount, error) {
+	rc.mutex.Lock()
+	defer rc.mutex.Unlock()
+	if rc.counts != nil && time.Since(rc.countedAt) < runsCollectorCacheDuration {
+		return rc.counts, nil
+	}
+
+	counts, err := rc.orm.JobRunStatusCounts()
+	if err != nil {
+		return nil, err
+	}
+	if counts == nil {
+		counts = []orm.JobRunStatusCount{}
+	}
+	rc.counts, rc.countedAt = counts, time.Now()
+	return counts, nil
+}
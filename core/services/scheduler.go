@@ -91,6 +91,13 @@ func (s *Scheduler) AddJob(job models.JobSpec) {
 	s.addJob(&job)
 }
 
+// RemoveJob stops scheduling runs for the job, so that it can be archived or
+// added again with a new version.
+func (s *Scheduler) RemoveJob(ID *models.ID) {
+	s.Recurring.RemoveJob(ID)
+	s.OneTime.RemoveJob(ID)
+}
+
 // Recurring is used for runs that need to execute on a schedule,
 // and is configured with cron.
 // Instances of Recurring must be initialized using NewRecurring().
@@ -98,12 +105,19 @@ type Recurring struct {
 	Cron       Cron
 	Clock      utils.Nower
 	runManager RunManager
+
+	// versions holds the version of each job whose schedules are active.
+	// Cron entries can't be removed, so entries of removed or superseded
+	// versions stay scheduled but no longer create runs.
+	versionsMutex sync.RWMutex
+	versions      map[string]uint32
 }
 
 // NewRecurring create a new instance of Recurring, ready to use.
 func NewRecurring(runManager RunManager) *Recurring {
 	return &Recurring{
 		runManager: runManager,
+		versions:   make(map[string]uint32),
 	}
 }
 
@@ -123,8 +137,15 @@ func (r *Recurring) Stop() {
 // AddJob looks for "cron" initiators, adds them to cron's schedule
 // for execution when specified.
 func (r *Recurring) AddJob(job models.JobSpec) {
+	r.versionsMutex.Lock()
+	r.versions[job.ID.String()] = job.Version
+	r.versionsMutex.Unlock()
+
 	for _, initr := range job.InitiatorsFor(models.InitiatorCron) {
 		r.Cron.AddFunc(string(initr.Schedule), func() {
+			if !r.active(job) {
+				return
+			}
 			_, err := r.runManager.Create(job.ID, &initr, &models.JSON{}, nil, &models.RunRequest{})
 			if err != nil && !expectedRecurringScheduleJobError(err) {
 				logger.Errorw(err.Error())
@@ -133,12 +154,29 @@ func (r *Recurring) AddJob(job models.JobSpec) {
 	}
 }
 
+// RemoveJob stops the job's cron initiators from creating runs.
+func (r *Recurring) RemoveJob(ID *models.ID) {
+	r.versionsMutex.Lock()
+	defer r.versionsMutex.Unlock()
+	delete(r.versions, ID.String())
+}
+
+func (r *Recurring) active(job models.JobSpec) bool {
+	r.versionsMutex.RLock()
+	defer r.versionsMutex.RUnlock()
+	version, ok := r.versions[job.ID.String()]
+	return ok && version == job.Version
+}
+
 // OneTime represents runs that are to be executed only once.
 type OneTime struct {
 	Store      *store.Store
 	Clock      utils.Afterer
 	RunManager RunManager
 	done       chan struct{}
+
+	removedMutex sync.Mutex
+	removed      map[string]chan struct{}
 }
 
 // Start allocates a channel for the "done" field with an empty struct.
@@ -149,13 +187,31 @@ func (ot *OneTime) Start() error {
 
 // AddJob runs the job at the time specified for the "runat" initiator.
 func (ot *OneTime) AddJob(job models.JobSpec) {
+	removed := make(chan struct{})
+	ot.removedMutex.Lock()
+	if ot.removed == nil {
+		ot.removed = make(map[string]chan struct{})
+	}
+	ot.removed[job.ID.String()] = removed
+	ot.removedMutex.Unlock()
+
 	for _, initiator := range job.InitiatorsFor(models.InitiatorRunAt) {
 		if !initiator.Time.Valid {
 			logger.Errorf("RunJobAt: JobSpec %s must have initiator with valid run at time: %v", job.ID, initiator)
 			continue
 		}
 
-		go ot.RunJobAt(initiator, job)
+		go ot.runJobAt(initiator, job, removed)
+	}
+}
+
+// RemoveJob stops waiting to run the job's runat initiators.
+func (ot *OneTime) RemoveJob(ID *models.ID) {
+	ot.removedMutex.Lock()
+	defer ot.removedMutex.Unlock()
+	if removed, ok := ot.removed[ID.String()]; ok {
+		close(removed)
+		delete(ot.removed, ID.String())
 	}
 }
 
@@ -164,12 +220,28 @@ func (ot *OneTime) Stop() {
 	close(ot.done)
 }
 
-// RunJobAt wait until the Stop() function has been called on the run
-// or the specified time for the run is after the present time.
+// RunJobAt wait until the Stop() function has been called on the run, the
+// job has been removed, or the specified time for the run is after the
+// present time.
 func (ot *OneTime) RunJobAt(initiator models.Initiator, job models.JobSpec) {
+	ot.removedMutex.Lock()
+	removed := ot.removed[job.ID.String()]
+	ot.removedMutex.Unlock()
+
+	ot.runJobAt(initiator, job, removed)
+}
+
+func (ot *OneTime) runJobAt(initiator models.Initiator, job models.JobSpec, removed chan struct{}) {
 	select {
 	case <-ot.done:
+	case <-removed:
 	case <-ot.Clock.After(utils.DurationFromNow(initiator.Time.Time)):
+		// select picks at random when the job was removed as its time came
+		select {
+		case <-removed:
+			return
+		default:
+		}
 		_, err := ot.RunManager.Create(job.ID, &initiator, &models.JSON{}, nil, &models.RunRequest{})
 		if err != nil {
 			logger.Error(err.Error())
@@ -7,7 +7,9 @@ import (
 	"chainlink/core/internal/cltest"
 	"chainlink/core/internal/mocks"
 	"chainlink/core/services"
+	"chainlink/core/store/models"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -79,6 +81,52 @@ func TestRecurring_AddJob(t *testing.T) {
 	runManager.AssertExpectations(t)
 }
 
+func TestRecurring_RemoveJob(t *testing.T) {
+	runManager := new(mocks.RunManager)
+
+	r := services.NewRecurring(runManager)
+	cron := cltest.NewMockCron()
+	r.Cron = cron
+
+	job := cltest.NewJobWithSchedule("* * * * *")
+	r.AddJob(job)
+	r.RemoveJob(job.ID)
+
+	cron.RunEntries()
+
+	runManager.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRecurring_AddJob_NewVersion(t *testing.T) {
+	executeJobChannel := make(chan *models.Initiator, 2)
+	runManager := new(mocks.RunManager)
+	runManager.On("Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, nil).
+		Run(func(args mock.Arguments) {
+			executeJobChannel <- args.Get(1).(*models.Initiator)
+		}).
+		Once()
+
+	r := services.NewRecurring(runManager)
+	cron := cltest.NewMockCron()
+	r.Cron = cron
+
+	job := cltest.NewJobWithSchedule("* * * * *")
+	r.AddJob(job)
+
+	updated := cltest.NewJobWithSchedule("*/5 * * * *")
+	updated.ID = job.ID
+	updated.Version = job.Version + 1
+	r.RemoveJob(job.ID)
+	r.AddJob(updated)
+
+	cron.RunEntries()
+
+	initr := <-executeJobChannel
+	assert.Equal(t, models.Cron("*/5 * * * *"), initr.Schedule)
+	runManager.AssertExpectations(t)
+}
+
 func TestOneTime_AddJob(t *testing.T) {
 	store, cleanup := cltest.NewStore(t)
 	defer cleanup()
@@ -122,3 +170,34 @@ func TestOneTime_AddJob(t *testing.T) {
 
 	runManager.AssertExpectations(t)
 }
+
+func TestOneTime_RemoveJob(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	runManager := new(mocks.RunManager)
+	clock := cltest.NewTriggerClock(t)
+
+	ot := services.OneTime{
+		Clock:      clock,
+		Store:      store,
+		RunManager: runManager,
+	}
+	require.NoError(t, ot.Start())
+
+	j := cltest.NewJobWithRunAtInitiator(time.Now())
+	require.Nil(t, store.CreateJob(&j))
+
+	ot.AddJob(j)
+	ot.RemoveJob(j.ID)
+
+	// This should block because the removed job no longer listens on the channel
+	go clock.Trigger()
+
+	// Sleep for some time to make sure a call isn't made
+	time.Sleep(1 * time.Second)
+
+	ot.Stop()
+
+	runManager.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
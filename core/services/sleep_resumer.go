@@ -0,0 +1,67 @@
+package services
+
+import (
+	"sync"
+	"time"
+
+	"chainlink/core/logger"
+	"chainlink/core/utils"
+)
+
+// SleepResumerInterval is how often the SleepResumer checks for sleeping
+// tasks that are due.
+const SleepResumerInterval = time.Second
+
+// SleepResumer resumes runs whose sleeping tasks have reached their wake time.
+// Wake times are persisted with the task runs, so sleeps begun before the node
+// restarted are resumed as soon as it is started again.
+type SleepResumer struct {
+	runManager RunManager
+	clock      utils.Nower
+	done       chan struct{}
+	wg         sync.WaitGroup
+}
+
+// NewSleepResumer returns a new SleepResumer.
+func NewSleepResumer(runManager RunManager, clock utils.Nower) *SleepResumer {
+	return &SleepResumer{
+		runManager: runManager,
+		clock:      clock,
+		done:       make(chan struct{}),
+	}
+}
+
+// Start resumes all runs that are already due, then keeps checking for due
+// runs in the background until Stop is called.
+func (sr *SleepResumer) Start() error {
+	if err := sr.runManager.ResumeAllSleeping(sr.clock.Now()); err != nil {
+		return err
+	}
+
+	sr.wg.Add(1)
+	go sr.resumeLoop()
+	return nil
+}
+
+// Stop stops checking for due runs.
+func (sr *SleepResumer) Stop() {
+	close(sr.done)
+	sr.wg.Wait()
+}
+
+func (sr *SleepResumer) resumeLoop() {
+	defer sr.wg.Done()
+
+	ticker := time.NewTicker(SleepResumerInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := sr.runManager.ResumeAllSleeping(sr.clock.Now()); err != nil {
+				logger.Errorw("Error resuming sleeping runs", "error", err)
+			}
+		case <-sr.done:
+			return
+		}
+	}
+}
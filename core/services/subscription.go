@@ -81,10 +81,14 @@ type InitiatorSubscription struct {
 	Initiator  models.Initiator
 	store      *strpkg.Store
 	callback   func(*strpkg.Store, RunManager, models.LogRequest)
+	cursor     *models.LogCursor
 }
 
 // NewInitiatorSubscription creates a new InitiatorSubscription that feeds received
-// logs to the callback func parameter.
+// logs to the callback func parameter. The subscription resumes from the block
+// of the last log processed for the initiator, unless replaying from a
+// configured block, and otherwise starts at nextHead. Logs processed before
+// request no new runs.
 func NewInitiatorSubscription(
 	initr models.Initiator,
 	job models.JobSpec,
@@ -94,6 +98,23 @@ func NewInitiatorSubscription(
 	callback func(*strpkg.Store, RunManager, models.LogRequest),
 ) (InitiatorSubscription, error) {
 
+	cursor, err := store.FindLogCursor(initr.ID)
+	if err != nil {
+		return InitiatorSubscription{}, errors.Wrap(err, "NewInitiatorSubscription#FindLogCursor")
+	}
+	if cursor == nil {
+		// Without a head the subscription starts at the latest block, and the
+		// cursor is only saved once a log has been processed.
+		cursor = models.NewLogCursor(initr.ID, nextHead)
+		if nextHead != nil {
+			if err := store.SaveLogCursor(cursor); err != nil {
+				return InitiatorSubscription{}, errors.Wrap(err, "NewInitiatorSubscription#SaveLogCursor")
+			}
+		}
+	} else if store.Config.ReplayFromBlock() < 0 {
+		nextHead = cursor.FromBlock()
+	}
+
 	filter, err := models.FilterQueryFactory(initr, nextHead)
 	if err != nil {
 		return InitiatorSubscription{}, errors.Wrap(err, "NewInitiatorSubscription#FilterQueryFactory")
@@ -105,6 +126,7 @@ func NewInitiatorSubscription(
 		Initiator:  initr,
 		store:      store,
 		callback:   callback,
+		cursor:     cursor,
 	}
 
 	managedSub, err := NewManagedSubscription(store.TxManager, filter, sub.dispatchLog)
@@ -127,6 +149,20 @@ func (sub InitiatorSubscription) dispatchLog(log eth.Log) {
 		Log:       log,
 	}
 	sub.callback(sub.store, sub.runManager, base.LogRequest())
+
+	// The cursor moves back past removed logs so that the logs replacing them
+	// are backfilled after a restart.
+	var moved bool
+	if log.Removed {
+		moved = sub.cursor.Rewind(log)
+	} else {
+		moved = sub.cursor.Advance(log)
+	}
+	if moved {
+		if err := sub.store.SaveLogCursor(sub.cursor); err != nil {
+			logger.Errorw("Unable to save log cursor", "error", err, "job", sub.JobSpecID.String())
+		}
+	}
 }
 
 func loggerLogListening(initr models.Initiator, blockNumber *big.Int) {
@@ -141,8 +177,14 @@ func ReceiveLogRequest(store *strpkg.Store, runManager RunManager, le models.Log
 		return
 	}
 
-	if le.GetLog().Removed {
-		logger.Debugw("Skipping run for removed log", "log", le.GetLog(), "jobId", le.GetJobSpecID().String())
+	if log := le.GetLog(); log.Removed {
+		logger.Debugw("Cancelling runs for removed log", "log", log, "jobId", le.GetJobSpecID().String())
+		runIDs, err := store.UnfinishedJobRunIDsForLog(le.GetJobSpecID(), log.TxHash, log.BlockHash)
+		if err != nil {
+			logger.Errorw(err.Error(), le.ForLogger()...)
+			return
+		}
+		cancelReorgedRuns(runManager, runIDs)
 		return
 	}
 
@@ -177,6 +219,14 @@ func runJob(store *strpkg.Store, runManager RunManager, le models.LogRequest, da
 		return
 	}
 
+	if requested, err := store.LogRunRequested(rr); err != nil {
+		logger.Errorw(err.Error(), le.ForLogger()...)
+		return
+	} else if requested {
+		logger.Debugw("Skipping run for log that already requested one", le.ForLogger()...)
+		return
+	}
+
 	_, err = runManager.Create(jobSpecID, &initiator, &data, le.BlockNumber(), &rr)
 	if err != nil {
 		logger.Errorw(err.Error(), le.ForLogger()...)
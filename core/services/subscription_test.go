@@ -109,6 +109,105 @@ func TestServices_NewInitiatorSubscription_PreventsDoubleDispatch(t *testing.T)
 	g.Eventually(func() int32 { return atomic.LoadInt32(&count) }).Should(gomega.Equal(int32(2)))
 }
 
+func TestServices_NewInitiatorSubscription_ResumesFromCursor(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	eth := cltest.MockEthOnStore(t, store)
+
+	job := cltest.NewJobWithLogInitiator()
+	require.NoError(t, store.CreateJob(&job))
+	initr := job.Initiators[0]
+
+	cursor := models.NewLogCursor(initr.ID, big.NewInt(50))
+	cursor.Advance(ethpkg.Log{BlockNumber: 50, Index: 1})
+	require.NoError(t, store.SaveLogCursor(cursor))
+
+	logs := []ethpkg.Log{
+		{BlockNumber: 50, Index: 1, BlockHash: cltest.NewHash()},
+		{BlockNumber: 60, Index: 0, BlockHash: cltest.NewHash()},
+	}
+	eth.Register("eth_getLogs", logs, func(_ interface{}, arg ...interface{}) error {
+		filter := arg[0].([]interface{})[0].(map[string]interface{})
+		assert.Equal(t, "0x32", filter["fromBlock"])
+		return nil
+	})
+	eth.RegisterSubscription("logs")
+
+	var count int32
+	callback := func(*strpkg.Store, services.RunManager, models.LogRequest) { atomic.AddInt32(&count, 1) }
+	jm := new(mocks.RunManager)
+	sub, err := services.NewInitiatorSubscription(initr, job, store, jm, big.NewInt(92), callback)
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	eth.EventuallyAllCalled(t)
+	g := gomega.NewGomegaWithT(t)
+	g.Eventually(func() int32 { return atomic.LoadInt32(&count) }).Should(gomega.Equal(int32(2)))
+	g.Eventually(func() int64 {
+		cursor, err := store.FindLogCursor(initr.ID)
+		require.NoError(t, err)
+		return cursor.BlockNumber
+	}).Should(gomega.Equal(int64(60)))
+}
+
+func TestServices_NewInitiatorSubscription_SavesCursorAtNextHead(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	eth := cltest.MockEthOnStore(t, store)
+	eth.Register("eth_getLogs", []ethpkg.Log{})
+	eth.RegisterSubscription("logs")
+
+	job := cltest.NewJobWithLogInitiator()
+	require.NoError(t, store.CreateJob(&job))
+	initr := job.Initiators[0]
+
+	callback := func(*strpkg.Store, services.RunManager, models.LogRequest) {}
+	jm := new(mocks.RunManager)
+	sub, err := services.NewInitiatorSubscription(initr, job, store, jm, big.NewInt(92), callback)
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+	eth.EventuallyAllCalled(t)
+
+	cursor, err := store.FindLogCursor(initr.ID)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(92), cursor.FromBlock())
+}
+
+func TestServices_ReceiveLogRequest_SkipsLogThatRequestedARun(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	jobSpec := cltest.NewJobWithLogInitiator()
+	require.NoError(t, store.CreateJob(&jobSpec))
+	initr := jobSpec.Initiators[0]
+
+	log := models.InitiatorLogEvent{
+		JobSpecID: *jobSpec.ID,
+		Initiator: initr,
+		Log: ethpkg.Log{
+			BlockNumber: 10,
+			BlockHash:   cltest.NewHash(),
+			TxHash:      cltest.NewHash(),
+			Index:       3,
+		},
+	}
+	rr, err := log.RunRequest()
+	require.NoError(t, err)
+	run := jobSpec.NewRun(initr)
+	run.RunRequest = rr
+	require.NoError(t, store.CreateJobRun(&run))
+
+	jm := new(mocks.RunManager)
+	services.ReceiveLogRequest(store, jm, log)
+	jm.AssertExpectations(t)
+}
+
 func TestServices_ReceiveLogRequest_IgnoredLogWithRemovedFlag(t *testing.T) {
 	t.Parallel()
 
@@ -133,6 +232,38 @@ func TestServices_ReceiveLogRequest_IgnoredLogWithRemovedFlag(t *testing.T) {
 	jm.AssertExpectations(t)
 }
 
+func TestServices_ReceiveLogRequest_CancelsRunsForRemovedLog(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	jobSpec := cltest.NewJobWithLogInitiator()
+	require.NoError(t, store.CreateJob(&jobSpec))
+
+	txHash := cltest.NewHash()
+	blockHash := cltest.NewHash()
+	run := jobSpec.NewRun(jobSpec.Initiators[0])
+	run.Status = models.RunStatusInProgress
+	run.RunRequest.TxHash = &txHash
+	run.RunRequest.BlockHash = &blockHash
+	require.NoError(t, store.CreateJobRun(&run))
+
+	log := models.InitiatorLogEvent{
+		JobSpecID: *jobSpec.ID,
+		Log: ethpkg.Log{
+			TxHash:    txHash,
+			BlockHash: blockHash,
+			Removed:   true,
+		},
+	}
+
+	jm := new(mocks.RunManager)
+	jm.On("Cancel", run.ID).Return(&run, nil)
+	services.ReceiveLogRequest(store, jm, log)
+	jm.AssertExpectations(t)
+}
+
 func TestServices_StartJobSubscription(t *testing.T) {
 	t.Parallel()
 
@@ -18,6 +18,12 @@ import (
 // ValidateJob checks the job and its associated Initiators and Tasks for any
 // application logic errors.
 func ValidateJob(j models.JobSpec, store *store.Store) error {
+	return validateJob(j, store, nil)
+}
+
+// validateJob is ValidateJob, with tasks of the types of the given bridges
+// validated against them rather than the bridges saved on the node.
+func validateJob(j models.JobSpec, store *store.Store, bridges map[models.TaskType]models.BridgeType) error {
 	fe := models.NewJSONAPIErrors()
 	if j.StartAt.Valid && j.EndAt.Valid && j.StartAt.Time.After(j.EndAt.Time) {
 		fe.Add("StartAt cannot be before EndAt")
@@ -31,10 +37,13 @@ func ValidateJob(j models.JobSpec, store *store.Store) error {
 		}
 	}
 	for _, task := range j.Tasks {
-		if err := validateTask(task, store); err != nil {
+		if err := validateTask(task, store, bridges); err != nil {
 			fe.Merge(err)
 		}
 	}
+	if err := validateTaskGraph(j); err != nil {
+		fe.Merge(err)
+	}
 	return fe.CoerceEmptyToNil()
 }
 
@@ -164,21 +173,103 @@ func validateServiceAgreementInitiator(i models.Initiator, j models.JobSpec) err
 	return fe.CoerceEmptyToNil()
 }
 
-func validateTask(task models.TaskSpec, store *store.Store) error {
-	adapter, err := adapters.For(task, store.Config, store.ORM)
-	if !store.Config.Dev() {
-		if _, ok := adapter.BaseAdapter.(*adapters.Sleep); ok {
-			return errors.New("Sleep Adapter is not implemented yet")
+func validateTask(task models.TaskSpec, store *store.Store, bridges map[models.TaskType]models.BridgeType) error {
+	if r, ok := adapters.Lookup(task.Type); ok && r.DevOnly && !store.Config.Dev() {
+		return fmt.Errorf("%s Adapter is not implemented yet", task.Type)
+	}
+	if bt, ok := bridges[task.Type]; ok {
+		_, err := adapters.ForBridge(task, bt)
+		return err
+	}
+	_, err := adapters.For(task, store.Config, store.ORM)
+	return err
+}
+
+// validateTaskGraph checks that task IDs are unique, that every input and
+// condition names another task in the job, and that they do not form a
+// cycle. Outside of a task graph, conditions must name an earlier task.
+func validateTaskGraph(j models.JobSpec) error {
+	fe := models.NewJSONAPIErrors()
+	tasks := map[string]models.TaskSpec{}
+	for _, task := range j.Tasks {
+		if task.TaskID == "" {
+			continue
 		}
-		if _, ok := adapter.BaseAdapter.(*adapters.EthTxABIEncode); ok {
-			return errors.New("EthTxABIEncode Adapter is not implemented yet")
+		if _, exists := tasks[task.TaskID]; exists {
+			fe.Add(fmt.Sprintf("Task ID %s is used by more than one task", task.TaskID))
 		}
+		tasks[task.TaskID] = task
 	}
-	return err
+
+	graph := false
+	for _, task := range j.Tasks {
+		graph = graph || len(task.Inputs) > 0
+	}
+	earlier := map[string]bool{}
+	for _, task := range j.Tasks {
+		for _, input := range task.Inputs {
+			if input == task.TaskID {
+				fe.Add(fmt.Sprintf("Task %s cannot be its own input", input))
+			} else if _, exists := tasks[input]; !exists {
+				fe.Add(fmt.Sprintf("Task input %s does not match any task ID", input))
+			}
+		}
+		if task.Condition != "" {
+			condition, _ := task.ConditionTaskID()
+			if _, exists := tasks[condition]; !exists {
+				fe.Add(fmt.Sprintf("Task condition %s does not match any task ID", task.Condition))
+			} else if condition == task.TaskID {
+				fe.Add(fmt.Sprintf("Task %s cannot be its own condition", condition))
+			} else if !graph && !earlier[condition] {
+				fe.Add(fmt.Sprintf("Task condition %s must name an earlier task", task.Condition))
+			}
+		}
+		if task.TaskID != "" {
+			earlier[task.TaskID] = true
+		}
+	}
+	if len(fe.Errors) > 0 {
+		return fe
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := map[string]int{}
+	var visit func(taskID string) bool
+	visit = func(taskID string) bool {
+		switch state[taskID] {
+		case visiting:
+			return false
+		case visited:
+			return true
+		}
+		state[taskID] = visiting
+		for _, dependency := range tasks[taskID].Dependencies() {
+			if !visit(dependency) {
+				return false
+			}
+		}
+		state[taskID] = visited
+		return true
+	}
+	for taskID := range tasks {
+		if !visit(taskID) {
+			fe.Add("Task inputs cannot form a cycle")
+			break
+		}
+	}
+	return fe.CoerceEmptyToNil()
 }
 
 // ValidateServiceAgreement checks the ServiceAgreement for any application logic errors.
 func ValidateServiceAgreement(sa models.ServiceAgreement, store *store.Store) error {
+	return validateServiceAgreement(sa, store, nil)
+}
+
+func validateServiceAgreement(sa models.ServiceAgreement, store *store.Store, bridges map[models.TaskType]models.BridgeType) error {
 	fe := models.NewJSONAPIErrors()
 	config := store.Config
 
@@ -207,7 +298,7 @@ func ValidateServiceAgreement(sa models.ServiceAgreement, store *store.Store) er
 		fe.Add("Service agreement encumbrance error: This node must be listed in the participating oracles")
 	}
 
-	if err := ValidateJob(sa.JobSpec, store); err != nil {
+	if err := validateJob(sa.JobSpec, store, bridges); err != nil {
 		fe.Add(fmt.Sprintf("Service agreement job spec error: Job spec validation: %v", err))
 	}
 
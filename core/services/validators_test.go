@@ -84,6 +84,21 @@ func TestValidateJob(t *testing.T) {
 	}
 }
 
+func TestValidateJob_JSONParseQuery(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{cltest.NewTask(t, "jsonparse", `{"query":"data[?id == 'b'] | [0].price"}`)}
+	assert.NoError(t, services.ValidateJob(job, store))
+
+	job.Tasks = []models.TaskSpec{cltest.NewTask(t, "jsonparse", `{"query":"data[?id =="}`)}
+	err := services.ValidateJob(job, store)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid JSONParse query")
+}
+
 func TestValidateJob_DevRejectsSleepAdapter(t *testing.T) {
 	store, cleanup := cltest.NewStore(t)
 	defer cleanup()
@@ -307,3 +322,82 @@ func TestValidateServiceAgreement(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateJob_TaskGraph(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	tests := []struct {
+		name  string
+		tasks string
+		want  error
+	}{
+		{
+			"fan out and join",
+			`[{"type": "noop", "taskId": "a"}, {"type": "noop", "taskId": "b"}, {"type": "noop", "inputs": ["a", "b"]}]`,
+			nil,
+		},
+		{
+			"duplicate task IDs",
+			`[{"type": "noop", "taskId": "a"}, {"type": "noop", "taskId": "a"}, {"type": "noop", "inputs": ["a"]}]`,
+			models.NewJSONAPIErrorsWith("Task ID a is used by more than one task"),
+		},
+		{
+			"unknown input",
+			`[{"type": "noop", "taskId": "a"}, {"type": "noop", "inputs": ["b"]}]`,
+			models.NewJSONAPIErrorsWith("Task input b does not match any task ID"),
+		},
+		{
+			"own input",
+			`[{"type": "noop", "taskId": "a", "inputs": ["a"]}]`,
+			models.NewJSONAPIErrorsWith("Task a cannot be its own input"),
+		},
+		{
+			"cycle",
+			`[{"type": "noop", "taskId": "a", "inputs": ["b"]}, {"type": "noop", "taskId": "b", "inputs": ["a"]}]`,
+			models.NewJSONAPIErrorsWith("Task inputs cannot form a cycle"),
+		},
+		{
+			"condition on earlier task",
+			`[{"type": "noop", "taskId": "a"}, {"type": "noop", "condition": "!a"}]`,
+			nil,
+		},
+		{
+			"condition on later task",
+			`[{"type": "noop", "condition": "a"}, {"type": "noop", "taskId": "a"}]`,
+			models.NewJSONAPIErrorsWith("Task condition a must name an earlier task"),
+		},
+		{
+			"unknown condition",
+			`[{"type": "noop", "taskId": "a"}, {"type": "noop", "condition": "!b"}]`,
+			models.NewJSONAPIErrorsWith("Task condition !b does not match any task ID"),
+		},
+		{
+			"own condition",
+			`[{"type": "noop", "taskId": "a", "condition": "a"}]`,
+			models.NewJSONAPIErrorsWith("Task a cannot be its own condition"),
+		},
+		{
+			"condition in graph",
+			`[{"type": "noop", "taskId": "a", "condition": "b"}, {"type": "noop", "taskId": "b"}, {"type": "noop", "inputs": ["a"]}]`,
+			nil,
+		},
+		{
+			"condition cycle",
+			`[{"type": "noop", "taskId": "a", "condition": "b"}, {"type": "noop", "taskId": "b", "inputs": ["a"]}]`,
+			models.NewJSONAPIErrorsWith("Task inputs cannot form a cycle"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var jsr models.JobSpecRequest
+			body := fmt.Sprintf(`{"initiators": [{"type": "web"}], "tasks": %s}`, test.tasks)
+			require.NoError(t, json.Unmarshal([]byte(body), &jsr))
+			j := models.NewJobFromRequest(jsr)
+			assert.Equal(t, test.want, services.ValidateJob(j, store))
+		})
+	}
+}
@@ -0,0 +1,249 @@
+package store
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"chainlink/core/eth"
+	"chainlink/core/logger"
+	"chainlink/core/store/models"
+	"chainlink/core/store/orm"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	// GasEstimatorFixed always suggests EthGasPriceDefault.
+	GasEstimatorFixed = "fixed"
+	// GasEstimatorPercentile suggests a percentile of the gas prices paid by
+	// the transactions in recent blocks.
+	GasEstimatorPercentile = "percentile"
+	// GasEstimatorNode suggests the gas price returned by eth_gasPrice.
+	GasEstimatorNode = "node"
+
+	// MinimumGasBumpPercent is the smallest bump Ethereum nodes accept for
+	// replacing a pending transaction.
+	MinimumGasBumpPercent = 10
+)
+
+// GasEstimator suggests the gas price of new transactions.
+type GasEstimator interface {
+	EstimateGasPrice() (*big.Int, error)
+}
+
+// GasBumper raises the gas price of a transaction that has not been
+// confirmed in time.
+type GasBumper interface {
+	BumpGasPrice(gasPriceWei *big.Int) *big.Int
+}
+
+// NewGasEstimator returns the estimator named by the config's
+// EthGasEstimator, falling back to the fixed estimator for unknown names.
+// The percentile estimator tracks blocks as a HeadTrackable.
+func NewGasEstimator(client eth.Client, config orm.ConfigReader) GasEstimator {
+	switch config.EthGasEstimator() {
+	case GasEstimatorFixed, "":
+		return &FixedGasEstimator{config: config}
+	case GasEstimatorPercentile:
+		return NewPercentileGasEstimator(client, config)
+	case GasEstimatorNode:
+		return &NodeGasEstimator{client: client}
+	default:
+		logger.Errorf("Unknown gas estimator %q, using %q", config.EthGasEstimator(), GasEstimatorFixed)
+		return &FixedGasEstimator{config: config}
+	}
+}
+
+// NewGasBumper returns a bumper raising gas prices by EthGasBumpPercent
+// percent when set, and by EthGasBumpWei otherwise.
+func NewGasBumper(config orm.ConfigReader) GasBumper {
+	if config.EthGasBumpPercent() > 0 {
+		return &PercentageGasBumper{Percent: config.EthGasBumpPercent()}
+	}
+	return &FixedGasBumper{config: config}
+}
+
+// LimitGasPrice returns the gas price raised to EthMinGasPriceWei, or lowered
+// to EthMaxGasPriceWei when that is set.
+func LimitGasPrice(gasPriceWei *big.Int, config orm.ConfigReader) *big.Int {
+	if floor := config.EthMinGasPriceWei(); gasPriceWei.Cmp(floor) < 0 {
+		return floor
+	}
+	if ceiling := config.EthMaxGasPriceWei(); ceiling.Sign() > 0 && gasPriceWei.Cmp(ceiling) > 0 {
+		return ceiling
+	}
+	return gasPriceWei
+}
+
+// FixedGasEstimator suggests the configured default gas price.
+type FixedGasEstimator struct {
+	config orm.ConfigReader
+}
+
+// EstimateGasPrice returns EthGasPriceDefault.
+func (e *FixedGasEstimator) EstimateGasPrice() (*big.Int, error) {
+	return e.config.EthGasPriceDefault(), nil
+}
+
+// NodeGasEstimator suggests the gas price the Ethereum node suggests.
+type NodeGasEstimator struct {
+	client eth.Client
+}
+
+// EstimateGasPrice returns the result of eth_gasPrice.
+func (e *NodeGasEstimator) EstimateGasPrice() (*big.Int, error) {
+	return e.client.GetGasPrice()
+}
+
+// PercentileGasEstimator suggests a percentile of the gas prices paid by the
+// transactions in the most recent blocks, which it fetches on every new head.
+type PercentileGasEstimator struct {
+	client       eth.Client
+	config       orm.ConfigReader
+	percentile   uint
+	blockHistory int64
+	mutex        sync.RWMutex
+	prices       map[int64][]*big.Int
+
+	headMutex sync.Mutex
+	fetching  bool
+	next      *int64
+}
+
+// NewPercentileGasEstimator returns an estimator with no blocks tracked yet.
+func NewPercentileGasEstimator(client eth.Client, config orm.ConfigReader) *PercentileGasEstimator {
+	percentile := config.EthGasPricePercentile()
+	if percentile > 100 {
+		percentile = 100
+	}
+	return &PercentileGasEstimator{
+		client:       client,
+		config:       config,
+		percentile:   percentile,
+		blockHistory: int64(config.EthGasPriceBlockHistory()),
+		prices:       make(map[int64][]*big.Int),
+	}
+}
+
+// EstimateGasPrice returns the percentile of the prices of the transactions
+// in the tracked blocks, or EthGasPriceDefault when there are none.
+func (e *PercentileGasEstimator) EstimateGasPrice() (*big.Int, error) {
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+
+	var prices []*big.Int
+	for _, blockPrices := range e.prices {
+		prices = append(prices, blockPrices...)
+	}
+	if len(prices) == 0 {
+		return e.config.EthGasPriceDefault(), nil
+	}
+
+	sort.Slice(prices, func(i, j int) bool {
+		return prices[i].Cmp(prices[j]) < 0
+	})
+	index := (len(prices) - 1) * int(e.percentile) / 100
+	return new(big.Int).Set(prices[index]), nil
+}
+
+// Connect does nothing; blocks are fetched as heads arrive.
+func (e *PercentileGasEstimator) Connect(*models.Head) error {
+	return nil
+}
+
+// Disconnect does nothing.
+func (e *PercentileGasEstimator) Disconnect() {}
+
+// OnNewHead fetches the transactions of the new block in the background,
+// so as not to hold up the head tracker. Blocks are fetched one at a time,
+// and of the heads arriving during a fetch only the latest is fetched next.
+func (e *PercentileGasEstimator) OnNewHead(head *models.Head) {
+	number := head.Number
+
+	e.headMutex.Lock()
+	defer e.headMutex.Unlock()
+	e.next = &number
+	if !e.fetching {
+		e.fetching = true
+		go e.fetchBlocks()
+	}
+}
+
+// fetchBlocks fetches the next block until there is none left to fetch.
+func (e *PercentileGasEstimator) fetchBlocks() {
+	for {
+		e.headMutex.Lock()
+		next := e.next
+		e.next = nil
+		if next == nil {
+			e.fetching = false
+			e.headMutex.Unlock()
+			return
+		}
+		e.headMutex.Unlock()
+
+		e.fetchBlock(*next)
+	}
+}
+
+// fetchBlock records the gas prices of the block's transactions, forgetting
+// blocks that have fallen out of the history.
+func (e *PercentileGasEstimator) fetchBlock(number int64) {
+	block, err := e.client.GetBlockWithTransactions(hexutil.EncodeBig(big.NewInt(number)))
+	if err != nil {
+		logger.Warnw(fmt.Sprintf("Unable to fetch block %v for gas price estimation", number), "err", err)
+		return
+	}
+
+	prices := make([]*big.Int, len(block.Transactions))
+	for i, tx := range block.Transactions {
+		prices[i] = tx.GasPrice.ToInt()
+	}
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.prices[number] = prices
+	for n := range e.prices {
+		if n <= number-e.blockHistory || n > number {
+			delete(e.prices, n)
+		}
+	}
+}
+
+// OnReorg does nothing; the blocks of the new chain replace those removed
+// as their heads arrive.
+func (e *PercentileGasEstimator) OnReorg(*models.Reorg) {}
+
+// FixedGasBumper raises gas prices by EthGasBumpWei.
+type FixedGasBumper struct {
+	config orm.ConfigReader
+}
+
+// BumpGasPrice returns the gas price plus EthGasBumpWei.
+func (b *FixedGasBumper) BumpGasPrice(gasPriceWei *big.Int) *big.Int {
+	return new(big.Int).Add(gasPriceWei, b.config.EthGasBumpWei())
+}
+
+// PercentageGasBumper raises gas prices by a percentage, and by at least
+// MinimumGasBumpPercent.
+type PercentageGasBumper struct {
+	Percent uint
+}
+
+// BumpGasPrice returns the gas price raised by the percentage, rounded up,
+// and by at least 1 wei.
+func (b *PercentageGasBumper) BumpGasPrice(gasPriceWei *big.Int) *big.Int {
+	percent := b.Percent
+	if percent < MinimumGasBumpPercent {
+		percent = MinimumGasBumpPercent
+	}
+	bump := new(big.Int).Mul(gasPriceWei, big.NewInt(int64(percent)))
+	bump.Add(bump, big.NewInt(99))
+	bump.Div(bump, big.NewInt(100))
+	if bump.Sign() <= 0 {
+		bump.SetInt64(1)
+	}
+	return bump.Add(bump, gasPriceWei)
+}
@@ -0,0 +1,204 @@
+package store_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"chainlink/core/eth"
+	"chainlink/core/internal/cltest"
+	"chainlink/core/internal/mocks"
+	strpkg "chainlink/core/store"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func blockWithGasPrices(number int64, prices ...int64) eth.Block {
+	block := eth.Block{Number: hexutil.Big(*big.NewInt(number))}
+	for _, price := range prices {
+		block.Transactions = append(block.Transactions, eth.Transaction{
+			Hash:     cltest.NewHash(),
+			GasPrice: hexutil.Big(*big.NewInt(price)),
+		})
+	}
+	return block
+}
+
+func TestNewGasEstimator(t *testing.T) {
+	t.Parallel()
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+	client := new(mocks.Client)
+
+	tests := []struct {
+		name      string
+		estimator string
+		want      strpkg.GasEstimator
+	}{
+		{"fixed", "fixed", &strpkg.FixedGasEstimator{}},
+		{"percentile", "percentile", &strpkg.PercentileGasEstimator{}},
+		{"node", "node", &strpkg.NodeGasEstimator{}},
+		{"unknown", "bogus", &strpkg.FixedGasEstimator{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config.Set("ETH_GAS_ESTIMATOR", test.estimator)
+			assert.IsType(t, test.want, strpkg.NewGasEstimator(client, config))
+		})
+	}
+}
+
+func TestFixedGasEstimator_EstimateGasPrice(t *testing.T) {
+	t.Parallel()
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+
+	price, err := strpkg.NewGasEstimator(new(mocks.Client), config).EstimateGasPrice()
+	require.NoError(t, err)
+	assert.Equal(t, config.EthGasPriceDefault(), price)
+}
+
+func TestNodeGasEstimator_EstimateGasPrice(t *testing.T) {
+	t.Parallel()
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+	config.Set("ETH_GAS_ESTIMATOR", "node")
+
+	client := new(mocks.Client)
+	client.On("GetGasPrice").Return(big.NewInt(42), nil).Once()
+	client.On("GetGasPrice").Return(nil, errors.New("node down")).Once()
+	estimator := strpkg.NewGasEstimator(client, config)
+
+	price, err := estimator.EstimateGasPrice()
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(42), price)
+
+	_, err = estimator.EstimateGasPrice()
+	assert.Error(t, err)
+	client.AssertExpectations(t)
+}
+
+func TestPercentileGasEstimator_EstimateGasPrice(t *testing.T) {
+	t.Parallel()
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+	config.Set("ETH_GAS_PRICE_PERCENTILE", 50)
+	config.Set("ETH_GAS_PRICE_BLOCK_HISTORY", 2)
+
+	client := new(mocks.Client)
+	client.On("GetBlockWithTransactions", "0x1").Return(blockWithGasPrices(1, 100, 100, 100), nil)
+	client.On("GetBlockWithTransactions", "0x2").Return(blockWithGasPrices(2, 10, 20, 30), nil)
+	client.On("GetBlockWithTransactions", "0x3").Return(blockWithGasPrices(3, 1, 2, 3), nil)
+	failed := make(chan struct{})
+	client.On("GetBlockWithTransactions", "0x4").
+		Run(func(mock.Arguments) { close(failed) }).
+		Return(eth.Block{}, errors.New("node down"))
+	estimator := strpkg.NewPercentileGasEstimator(client, config)
+
+	price, err := estimator.EstimateGasPrice()
+	require.NoError(t, err)
+	assert.Equal(t, config.EthGasPriceDefault(), price)
+
+	g := gomega.NewGomegaWithT(t)
+	estimatedPrice := func() *big.Int {
+		price, err := estimator.EstimateGasPrice()
+		require.NoError(t, err)
+		return price
+	}
+
+	estimator.OnNewHead(cltest.Head(1))
+	g.Eventually(estimatedPrice).Should(gomega.Equal(big.NewInt(100)))
+
+	estimator.OnNewHead(cltest.Head(2))
+	g.Eventually(estimatedPrice).Should(gomega.Equal(big.NewInt(30)))
+
+	estimator.OnNewHead(cltest.Head(3))
+	g.Eventually(estimatedPrice).Should(gomega.Equal(big.NewInt(3)))
+
+	estimator.OnNewHead(cltest.Head(4))
+	g.Eventually(failed).Should(gomega.BeClosed())
+	g.Consistently(estimatedPrice).Should(gomega.Equal(big.NewInt(3)))
+}
+
+func TestPercentileGasEstimator_OnNewHead_FetchesInBackground(t *testing.T) {
+	t.Parallel()
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+	config.Set("ETH_GAS_PRICE_PERCENTILE", 50)
+	config.Set("ETH_GAS_PRICE_BLOCK_HISTORY", 2)
+
+	client := new(mocks.Client)
+	fetching := make(chan struct{})
+	proceed := make(chan struct{})
+	client.On("GetBlockWithTransactions", "0x1").
+		Run(func(mock.Arguments) {
+			close(fetching)
+			<-proceed
+		}).
+		Return(blockWithGasPrices(1, 100), nil)
+	client.On("GetBlockWithTransactions", "0x3").Return(blockWithGasPrices(3, 1), nil)
+	estimator := strpkg.NewPercentileGasEstimator(client, config)
+
+	estimator.OnNewHead(cltest.Head(1))
+	<-fetching
+	estimator.OnNewHead(cltest.Head(2))
+	estimator.OnNewHead(cltest.Head(3))
+	close(proceed)
+
+	g := gomega.NewGomegaWithT(t)
+	g.Eventually(func() *big.Int {
+		price, err := estimator.EstimateGasPrice()
+		require.NoError(t, err)
+		return price
+	}).Should(gomega.Equal(big.NewInt(1)))
+	client.AssertNotCalled(t, "GetBlockWithTransactions", "0x2")
+}
+
+func TestLimitGasPrice(t *testing.T) {
+	t.Parallel()
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+	config.Set("ETH_MIN_GAS_PRICE_WEI", "10")
+	config.Set("ETH_MAX_GAS_PRICE_WEI", "100")
+
+	assert.Equal(t, big.NewInt(10), strpkg.LimitGasPrice(big.NewInt(1), config))
+	assert.Equal(t, big.NewInt(50), strpkg.LimitGasPrice(big.NewInt(50), config))
+	assert.Equal(t, big.NewInt(100), strpkg.LimitGasPrice(big.NewInt(1000), config))
+
+	config.Set("ETH_MAX_GAS_PRICE_WEI", "0")
+	assert.Equal(t, big.NewInt(1000), strpkg.LimitGasPrice(big.NewInt(1000), config))
+}
+
+func TestNewGasBumper(t *testing.T) {
+	t.Parallel()
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+
+	bumper := strpkg.NewGasBumper(config)
+	assert.IsType(t, &strpkg.FixedGasBumper{}, bumper)
+	expected := new(big.Int).Add(big.NewInt(1000), config.EthGasBumpWei())
+	assert.Equal(t, expected, bumper.BumpGasPrice(big.NewInt(1000)))
+
+	config.Set("ETH_GAS_BUMP_PERCENT", 15)
+	bumper = strpkg.NewGasBumper(config)
+	assert.IsType(t, &strpkg.PercentageGasBumper{}, bumper)
+	assert.Equal(t, big.NewInt(1150), bumper.BumpGasPrice(big.NewInt(1000)))
+	assert.Equal(t, big.NewInt(2), bumper.BumpGasPrice(big.NewInt(1)))
+	assert.Equal(t, big.NewInt(1149), bumper.BumpGasPrice(big.NewInt(999)))
+
+	config.Set("ETH_GAS_BUMP_PERCENT", 5)
+	bumper = strpkg.NewGasBumper(config)
+	assert.Equal(t, big.NewInt(1100), bumper.BumpGasPrice(big.NewInt(1000)))
+}
@@ -0,0 +1,172 @@
+package store
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"chainlink/core/store/orm"
+
+	"github.com/pkg/errors"
+)
+
+// HTTPClientOptions overrides the node's outbound HTTP configuration for the
+// requests of a single adapter or bridge. Zero values fall back to the
+// node's configuration.
+type HTTPClientOptions struct {
+	Timeout            time.Duration
+	ClientCertPath     string
+	ClientKeyPath      string
+	CABundlePath       string
+	ProxyURL           string
+	DisableCompression bool
+}
+
+// HTTPClientPool provides the clients used for outbound HTTP requests, such
+// as to bridges and by the httpget and httppost adapters. Clients with the
+// same TLS and proxy settings share a transport, and so its connections.
+type HTTPClientPool struct {
+	config orm.ConfigReader
+
+	transportsMutex sync.Mutex
+	transports      map[transportKey]*http.Transport
+}
+
+type transportKey struct {
+	clientCertPath     string
+	clientKeyPath      string
+	caBundlePath       string
+	proxyURL           string
+	disableCompression bool
+}
+
+// NewHTTPClientPool returns a pool of HTTP clients configured by config.
+func NewHTTPClientPool(config orm.ConfigReader) *HTTPClientPool {
+	return &HTTPClientPool{
+		config:     config,
+		transports: make(map[transportKey]*http.Transport),
+	}
+}
+
+// Client returns a client for the given options.
+func (p *HTTPClientPool) Client(opts HTTPClientOptions) (*http.Client, error) {
+	timeout := opts.Timeout
+	if timeout == 0 {
+		timeout = p.config.DefaultHTTPTimeout()
+	}
+
+	transport, err := p.transport(p.transportKey(opts))
+	if err != nil {
+		return nil, err
+	}
+	return &http.Client{Transport: transport, Timeout: timeout}, nil
+}
+
+func (p *HTTPClientPool) transportKey(opts HTTPClientOptions) transportKey {
+	key := transportKey{
+		clientCertPath:     opts.ClientCertPath,
+		clientKeyPath:      opts.ClientKeyPath,
+		caBundlePath:       opts.CABundlePath,
+		proxyURL:           opts.ProxyURL,
+		disableCompression: opts.DisableCompression,
+	}
+	if key.clientCertPath == "" {
+		key.clientCertPath = p.config.HTTPClientCertPath()
+		key.clientKeyPath = p.config.HTTPClientKeyPath()
+	}
+	if key.caBundlePath == "" {
+		key.caBundlePath = p.config.HTTPCABundlePath()
+	}
+	if key.proxyURL == "" && p.config.HTTPProxyURL() != nil {
+		key.proxyURL = p.config.HTTPProxyURL().String()
+	}
+	return key
+}
+
+func (p *HTTPClientPool) transport(key transportKey) (*http.Transport, error) {
+	p.transportsMutex.Lock()
+	defer p.transportsMutex.Unlock()
+	if transport, ok := p.transports[key]; ok {
+		return transport, nil
+	}
+
+	tlsConfig, err := newTLSConfig(key)
+	if err != nil {
+		return nil, err
+	}
+
+	proxy := http.ProxyFromEnvironment
+	if key.proxyURL != "" {
+		proxyURL, err := url.Parse(key.proxyURL)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid HTTP proxy URL")
+		}
+		proxy = http.ProxyURL(proxyURL)
+	}
+
+	rules, err := newOutboundRules(p.config)
+	if err != nil {
+		return nil, err
+	}
+
+	transport := &http.Transport{
+		Proxy: rules.proxy(proxy),
+		DialContext: rules.dialContext(&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}),
+		TLSClientConfig:       tlsConfig,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+		DisableCompression:    key.disableCompression,
+		MaxIdleConns:          p.config.HTTPMaxIdleConns(),
+		MaxIdleConnsPerHost:   p.config.HTTPMaxIdleConnsPerHost(),
+		IdleConnTimeout:       p.config.HTTPIdleConnTimeout(),
+	}
+	p.transports[key] = transport
+	return transport, nil
+}
+
+func newTLSConfig(key transportKey) (*tls.Config, error) {
+	tlsConfig := &tls.Config{}
+
+	if key.clientCertPath != "" || key.clientKeyPath != "" {
+		cert, err := tls.LoadX509KeyPair(key.clientCertPath, key.clientKeyPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to load HTTP client certificate")
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if key.caBundlePath != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		pem, err := ioutil.ReadFile(key.caBundlePath)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to read HTTP CA bundle")
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in HTTP CA bundle %s", key.caBundlePath)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	return tlsConfig, nil
+}
+
+// CloseIdleConnections closes the idle connections of every client.
+func (p *HTTPClientPool) CloseIdleConnections() {
+	p.transportsMutex.Lock()
+	defer p.transportsMutex.Unlock()
+	for _, transport := range p.transports {
+		transport.CloseIdleConnections()
+	}
+}
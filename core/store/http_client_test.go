@@ -0,0 +1,266 @@
+package store_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"chainlink/core/internal/cltest"
+	"chainlink/core/store"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPClientPool_Client_Timeout(t *testing.T) {
+	t.Parallel()
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+	config.Set("DEFAULT_HTTP_TIMEOUT", "7s")
+	pool := store.NewHTTPClientPool(config)
+
+	client, err := pool.Client(store.HTTPClientOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, 7*time.Second, client.Timeout)
+
+	overridden, err := pool.Client(store.HTTPClientOptions{Timeout: time.Second})
+	require.NoError(t, err)
+	assert.Equal(t, time.Second, overridden.Timeout)
+
+	assert.Equal(t, client.Transport, overridden.Transport, "clients with the same TLS and proxy settings should share connections")
+
+	proxied, err := pool.Client(store.HTTPClientOptions{ProxyURL: "http://proxy.example.com"})
+	require.NoError(t, err)
+	assert.NotEqual(t, client.Transport, proxied.Transport)
+}
+
+func TestHTTPClientPool_Client_MutualTLS(t *testing.T) {
+	t.Parallel()
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+	dir, err := ioutil.TempDir("", "http_client_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	certPath, keyPath := writeTestCertificate(t, dir)
+
+	cert, err := tls.LoadX509KeyPair(certPath, keyPath)
+	require.NoError(t, err)
+	caPool := x509.NewCertPool()
+	caPool.AddCert(mustParseCertificate(t, cert))
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    caPool,
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	pool := store.NewHTTPClientPool(config)
+
+	untrusted, err := pool.Client(store.HTTPClientOptions{})
+	require.NoError(t, err)
+	_, err = untrusted.Get(server.URL)
+	assert.Error(t, err)
+
+	withoutCert, err := pool.Client(store.HTTPClientOptions{CABundlePath: certPath})
+	require.NoError(t, err)
+	_, err = withoutCert.Get(server.URL)
+	assert.Error(t, err)
+
+	config.Set("HTTP_CLIENT_CERT_PATH", certPath)
+	config.Set("HTTP_CLIENT_KEY_PATH", keyPath)
+	mutual, err := pool.Client(store.HTTPClientOptions{CABundlePath: certPath})
+	require.NoError(t, err)
+	resp, err := mutual.Get(server.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestHTTPClientPool_Client_MissingCertificate(t *testing.T) {
+	t.Parallel()
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+	pool := store.NewHTTPClientPool(config)
+
+	_, err := pool.Client(store.HTTPClientOptions{ClientCertPath: "/nonexistent.crt", ClientKeyPath: "/nonexistent.key"})
+	assert.Error(t, err)
+	_, err = pool.Client(store.HTTPClientOptions{CABundlePath: "/nonexistent.pem"})
+	assert.Error(t, err)
+}
+
+func TestHTTPClientPool_Client_OutboundRules(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	_, port, err := net.SplitHostPort(server.Listener.Addr().String())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		host    string
+		env     map[string]string
+		wantErr bool
+	}{
+		{"no rules", "127.0.0.1", nil, false},
+		{"blocked network", "127.0.0.1", map[string]string{"HTTP_BLOCKED_CIDRS": "10.0.0.0/8, 127.0.0.0/8"}, true},
+		{"blocked network by hostname", "localhost", map[string]string{"HTTP_BLOCKED_CIDRS": "127.0.0.0/8,::1/128"}, true},
+		{"blocked host", "localhost", map[string]string{"HTTP_BLOCKED_HOSTS": "LOCALHOST"}, true},
+		{"blocked subdomain", "localhost", map[string]string{"HTTP_BLOCKED_HOSTS": "*.localhost"}, false},
+		{"allowed network", "127.0.0.1", map[string]string{"HTTP_ALLOWED_CIDRS": "127.0.0.0/8"}, false},
+		{"not an allowed network", "127.0.0.1", map[string]string{"HTTP_ALLOWED_CIDRS": "10.0.0.0/8"}, true},
+		{"allowed host", "localhost", map[string]string{"HTTP_ALLOWED_CIDRS": "10.0.0.0/8", "HTTP_ALLOWED_HOSTS": "localhost"}, false},
+		{"not an allowed host", "127.0.0.1", map[string]string{"HTTP_ALLOWED_HOSTS": "localhost"}, true},
+		{"allowed host on a blocked network", "localhost", map[string]string{"HTTP_ALLOWED_HOSTS": "localhost", "HTTP_BLOCKED_CIDRS": "127.0.0.0/8,::1/128"}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config, cleanup := cltest.NewConfig(t)
+			defer cleanup()
+			for k, v := range test.env {
+				config.Set(k, v)
+			}
+			client, err := store.NewHTTPClientPool(config).Client(store.HTTPClientOptions{})
+			require.NoError(t, err)
+
+			resp, err := client.Get("http://" + net.JoinHostPort(test.host, port))
+			if test.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "not allowed by the node's outbound network configuration")
+			} else {
+				require.NoError(t, err)
+				resp.Body.Close()
+			}
+		})
+	}
+}
+
+func TestHTTPClientPool_Client_OutboundRulesThroughProxy(t *testing.T) {
+	t.Parallel()
+
+	var proxied []string
+	var mutex sync.Mutex
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		proxied = append(proxied, r.URL.Host)
+		mutex.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer proxy.Close()
+
+	tests := []struct {
+		name    string
+		url     string
+		env     map[string]string
+		wantErr bool
+	}{
+		{"no rules", "http://example.com", nil, false},
+		{"blocked host", "http://example.com", map[string]string{"HTTP_BLOCKED_HOSTS": "example.com"}, true},
+		{"blocked network", "http://10.1.2.3", map[string]string{"HTTP_BLOCKED_CIDRS": "10.0.0.0/8"}, true},
+		{"allowed network by hostname", "http://localhost.", map[string]string{"HTTP_ALLOWED_CIDRS": "127.0.0.0/8,::1/128"}, false},
+		{"not an allowed network", "http://10.1.2.3", map[string]string{"HTTP_ALLOWED_CIDRS": "127.0.0.0/8"}, true},
+		{"not an allowed host", "http://example.com", map[string]string{"HTTP_ALLOWED_HOSTS": "example.org,127.0.0.1"}, true},
+		{"allowed host", "http://example.org", map[string]string{"HTTP_ALLOWED_HOSTS": "example.org,127.0.0.1", "HTTP_BLOCKED_HOSTS": "example.com"}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config, cleanup := cltest.NewConfig(t)
+			defer cleanup()
+			for k, v := range test.env {
+				config.Set(k, v)
+			}
+			client, err := store.NewHTTPClientPool(config).Client(store.HTTPClientOptions{ProxyURL: proxy.URL})
+			require.NoError(t, err)
+
+			mutex.Lock()
+			proxied = nil
+			mutex.Unlock()
+			resp, err := client.Get(test.url)
+			mutex.Lock()
+			defer mutex.Unlock()
+			if test.wantErr {
+				require.Error(t, err)
+				ruleErr, ok := store.AsOutboundConnectionError(err)
+				require.True(t, ok, err.Error())
+				assert.NotEqual(t, "127.0.0.1", ruleErr.Host, "the proxy should be allowed")
+				assert.Empty(t, proxied)
+			} else {
+				require.NoError(t, err)
+				resp.Body.Close()
+				assert.Len(t, proxied, 1)
+			}
+		})
+	}
+}
+
+func TestHTTPClientPool_Client_InvalidCIDR(t *testing.T) {
+	t.Parallel()
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+	config.Set("HTTP_BLOCKED_CIDRS", "169.254.169.254")
+
+	_, err := store.NewHTTPClientPool(config).Client(store.HTTPClientOptions{})
+	assert.Error(t, err)
+}
+
+// writeTestCertificate writes a self signed certificate for 127.0.0.1, usable
+// by both servers and clients, and its key to dir.
+func writeTestCertificate(t *testing.T, dir string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "127.0.0.1"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certPath := filepath.Join(dir, "client.crt")
+	keyPath := filepath.Join(dir, "client.key")
+	require.NoError(t, ioutil.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, ioutil.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600))
+	return certPath, keyPath
+}
+
+func mustParseCertificate(t *testing.T, cert tls.Certificate) *x509.Certificate {
+	parsed, err := x509.ParseCertificate(cert.Certificate[0])
+	require.NoError(t, err)
+	return parsed
+}
@@ -28,6 +28,20 @@ import (
 	"chainlink/core/store/migrations/migration1573667511"
 	"chainlink/core/store/migrations/migration1573812490"
 	"chainlink/core/store/migrations/migration1575036327"
+	"chainlink/core/store/migrations/migration1576522547"
+	"chainlink/core/store/migrations/migration1576608912"
+	"chainlink/core/store/migrations/migration1576696213"
+	"chainlink/core/store/migrations/migration1576782371"
+	"chainlink/core/store/migrations/migration1576868712"
+	"chainlink/core/store/migrations/migration1576955112"
+	"chainlink/core/store/migrations/migration1577041512"
+	"chainlink/core/store/migrations/migration1577127912"
+	"chainlink/core/store/migrations/migration1577214312"
+	"chainlink/core/store/migrations/migration1577300712"
+	"chainlink/core/store/migrations/migration1577387112"
+	"chainlink/core/store/migrations/migration1577473512"
+	"chainlink/core/store/migrations/migration1577559912"
+	"chainlink/core/store/migrations/migration1577646312"
 
 	"github.com/jinzhu/gorm"
 	"github.com/pkg/errors"
@@ -146,6 +160,62 @@ func MigrateTo(db *gorm.DB, migrationID string) error {
 			ID:      "1575036327",
 			Migrate: migration1575036327.Migrate,
 		},
+		{
+			ID:      "1576522547",
+			Migrate: migration1576522547.Migrate,
+		},
+		{
+			ID:      "1576608912",
+			Migrate: migration1576608912.Migrate,
+		},
+		{
+			ID:      "1576696213",
+			Migrate: migration1576696213.Migrate,
+		},
+		{
+			ID:      "1576782371",
+			Migrate: migration1576782371.Migrate,
+		},
+		{
+			ID:      "1576868712",
+			Migrate: migration1576868712.Migrate,
+		},
+		{
+			ID:      "1576955112",
+			Migrate: migration1576955112.Migrate,
+		},
+		{
+			ID:      "1577041512",
+			Migrate: migration1577041512.Migrate,
+		},
+		{
+			ID:      "1577127912",
+			Migrate: migration1577127912.Migrate,
+		},
+		{
+			ID:      "1577214312",
+			Migrate: migration1577214312.Migrate,
+		},
+		{
+			ID:      "1577300712",
+			Migrate: migration1577300712.Migrate,
+		},
+		{
+			ID:      "1577387112",
+			Migrate: migration1577387112.Migrate,
+		},
+		{
+			ID:      "1577473512",
+			Migrate: migration1577473512.Migrate,
+		},
+		{
+			ID:      "1577559912",
+			Migrate: migration1577559912.Migrate,
+		},
+		{
+			ID:      "1577646312",
+			Migrate: migration1577646312.Migrate,
+		},
 	}
 
 	m := gormigrate.New(db, &options, migrations)
@@ -12,6 +12,7 @@ import (
 	"chainlink/core/store/migrations"
 	"chainlink/core/store/migrations/migration0"
 	"chainlink/core/store/migrations/migration1560881855"
+	"chainlink/core/store/migrations/migration1577559912"
 	"chainlink/core/store/models"
 	"chainlink/core/store/orm"
 	"chainlink/core/utils"
@@ -177,11 +178,12 @@ func TestMigrate_Migration1565139192(t *testing.T) {
 		specOneFound := models.JobSpec{}
 		specTwoFound := models.JobSpec{}
 
-		require.NoError(t, db.Create(&specWithPayment).Error)
-		require.NoError(t, db.Create(&specNoPayment).Error)
-		require.NoError(t, db.Where("id = ?", specNoPayment.ID).Find(&specOneFound).Error)
+		// Columns added by later migrations are omitted
+		require.NoError(t, db.Omit("version").Create(&specWithPayment).Error)
+		require.NoError(t, db.Omit("version").Create(&specNoPayment).Error)
+		require.NoError(t, db.Select("id, min_payment").Where("id = ?", specNoPayment.ID).Find(&specOneFound).Error)
 		require.Equal(t, *assets.NewLink(0), specNoPayment.MinPayment)
-		require.NoError(t, db.Where("id = ?", specWithPayment.ID).Find(&specTwoFound).Error)
+		require.NoError(t, db.Select("id, min_payment").Where("id = ?", specWithPayment.ID).Find(&specTwoFound).Error)
 		require.Equal(t, *assets.NewLink(5), specWithPayment.MinPayment)
 		return nil
 	})
@@ -334,3 +336,53 @@ func TestMigrate_NewerVersionGuard(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestMigrate_Migration1577646312(t *testing.T) {
+	orm, cleanup := bootstrapORM(t)
+	defer cleanup()
+
+	err := orm.RawDB(func(db *gorm.DB) error {
+		require.NoError(t, migrations.MigrateTo(db, "1577559912"))
+
+		jobSpecID := models.NewID()
+		query := fmt.Sprintf(`
+INSERT INTO job_specs (id) VALUES ('%s');
+INSERT INTO initiators (id, job_spec_id, type) VALUES (1, '%s', 'runlog');
+INSERT INTO initiators (id, job_spec_id, type) VALUES (2, '%s', 'runlog');
+`, jobSpecID, jobSpecID, jobSpecID)
+		require.NoError(t, db.Exec(query).Error)
+
+		txHash := cltest.NewHash()
+		blockHash := cltest.NewHash()
+		logIndex := uint(3)
+		for _, initiatorID := range []uint{1, 2} {
+			initiatorID := initiatorID
+			rr := migration1577559912.RunRequest{
+				TxHash:      &txHash,
+				BlockHash:   &blockHash,
+				LogIndex:    &logIndex,
+				InitiatorID: &initiatorID,
+			}
+			require.NoError(t, db.Create(&rr).Error)
+		}
+
+		require.NoError(t, migrations.MigrateTo(db, "1577646312"))
+
+		var requests []models.RunRequest
+		require.NoError(t, db.Order("id asc").Find(&requests).Error)
+		require.Len(t, requests, 2)
+		require.NotNil(t, requests[0].JobSpecID)
+		assert.Equal(t, jobSpecID, requests[0].JobSpecID)
+		assert.Nil(t, requests[1].JobSpecID)
+
+		duplicate := models.RunRequest{
+			TxHash:    &txHash,
+			BlockHash: &blockHash,
+			LogIndex:  &logIndex,
+			JobSpecID: requests[0].JobSpecID,
+		}
+		assert.Error(t, db.Create(&duplicate).Error)
+		return nil
+	})
+	require.NoError(t, err)
+}
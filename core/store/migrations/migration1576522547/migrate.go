@@ -0,0 +1,18 @@
+package migration1576522547
+
+import (
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// Migrate adds the TaskID and Inputs columns to task_specs, allowing tasks to
+// be declared as a graph.
+func Migrate(tx *gorm.DB) error {
+	if err := tx.Exec(`ALTER TABLE task_specs ADD COLUMN "task_id" varchar(255);`).Error; err != nil {
+		return errors.Wrap(err, "could not add task_id to task_specs")
+	}
+	if err := tx.Exec(`ALTER TABLE task_specs ADD COLUMN "inputs" text;`).Error; err != nil {
+		return errors.Wrap(err, "could not add inputs to task_specs")
+	}
+	return nil
+}
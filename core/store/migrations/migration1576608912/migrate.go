@@ -0,0 +1,18 @@
+package migration1576608912
+
+import (
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// Migrate adds the attempts and last_error columns to task_runs, recording
+// retries made by adapters with a retry policy.
+func Migrate(tx *gorm.DB) error {
+	if err := tx.Exec(`ALTER TABLE task_runs ADD COLUMN "attempts" integer NOT NULL DEFAULT 0;`).Error; err != nil {
+		return errors.Wrap(err, "could not add attempts to task_runs")
+	}
+	if err := tx.Exec(`ALTER TABLE task_runs ADD COLUMN "last_error" text;`).Error; err != nil {
+		return errors.Wrap(err, "could not add last_error to task_runs")
+	}
+	return nil
+}
@@ -0,0 +1,14 @@
+package migration1576696213
+
+import (
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// Migrate adds the timeout column to task_specs.
+func Migrate(tx *gorm.DB) error {
+	if err := tx.Exec(`ALTER TABLE task_specs ADD COLUMN "timeout" bigint NOT NULL DEFAULT 0;`).Error; err != nil {
+		return errors.Wrap(err, "could not add timeout to task_specs")
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package migration1576782371
+
+import (
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// Migrate adds the wake_at column to task_runs, so that sleeping tasks can be
+// resumed after the node restarts.
+func Migrate(tx *gorm.DB) error {
+	if err := tx.AutoMigrate(&TaskRun{}).Error; err != nil {
+		return errors.Wrap(err, "failed to auto migrate TaskRun")
+	}
+	if err := tx.Exec(`CREATE INDEX task_runs_status_wake_at_idx ON task_runs ("status", "wake_at");`).Error; err != nil {
+		return errors.Wrap(err, "could not index task_runs wake_at")
+	}
+	return nil
+}
+
+// TaskRun is a capture of the model representing the wake_at column
+// introduced by this migration.
+type TaskRun struct {
+	ID     string `gorm:"primary_key;not null"`
+	WakeAt *time.Time
+}
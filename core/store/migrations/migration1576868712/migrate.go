@@ -0,0 +1,52 @@
+package migration1576868712
+
+import (
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// Migrate adds versions to job_specs, with the version that each initiator,
+// task spec and job run belongs to.
+func Migrate(tx *gorm.DB) error {
+	if err := tx.AutoMigrate(&JobSpec{}).Error; err != nil {
+		return errors.Wrap(err, "failed to auto migrate JobSpec")
+	}
+	if err := tx.AutoMigrate(&Initiator{}).Error; err != nil {
+		return errors.Wrap(err, "failed to auto migrate Initiator")
+	}
+	if err := tx.AutoMigrate(&TaskSpec{}).Error; err != nil {
+		return errors.Wrap(err, "failed to auto migrate TaskSpec")
+	}
+	if err := tx.AutoMigrate(&JobRun{}).Error; err != nil {
+		return errors.Wrap(err, "failed to auto migrate JobRun")
+	}
+	return nil
+}
+
+// JobSpec is a capture of the model representing the version column
+// introduced by this migration.
+type JobSpec struct {
+	ID      string `gorm:"primary_key;not null"`
+	Version uint32 `gorm:"not null;default:1"`
+}
+
+// Initiator is a capture of the model representing the job_spec_version
+// column introduced by this migration.
+type Initiator struct {
+	ID             uint   `gorm:"primary_key;auto_increment"`
+	JobSpecVersion uint32 `gorm:"not null;default:1"`
+}
+
+// TaskSpec is a capture of the model representing the job_spec_version
+// column introduced by this migration.
+type TaskSpec struct {
+	ID             uint   `gorm:"primary_key"`
+	JobSpecVersion uint32 `gorm:"not null;default:1"`
+}
+
+// JobRun is a capture of the model representing the job_spec_version column
+// introduced by this migration.
+type JobRun struct {
+	ID             string `gorm:"primary_key;not null"`
+	JobSpecVersion uint32 `gorm:"not null;default:1"`
+}
@@ -0,0 +1,22 @@
+package migration1576955112
+
+import (
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// Migrate adds the signing_secret column to bridge_types, used to sign
+// requests to a bridge and verify its callbacks.
+func Migrate(tx *gorm.DB) error {
+	if err := tx.AutoMigrate(&BridgeType{}).Error; err != nil {
+		return errors.Wrap(err, "failed to auto migrate BridgeType")
+	}
+	return nil
+}
+
+// BridgeType is a capture of the model representing the signing_secret
+// column introduced by this migration.
+type BridgeType struct {
+	Name          string `gorm:"primary_key"`
+	SigningSecret string
+}
@@ -0,0 +1,26 @@
+package migration1577041512
+
+import (
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// Migrate adds the columns overriding the outbound HTTP configuration for
+// requests to a bridge.
+func Migrate(tx *gorm.DB) error {
+	if err := tx.AutoMigrate(&BridgeType{}).Error; err != nil {
+		return errors.Wrap(err, "failed to auto migrate BridgeType")
+	}
+	return nil
+}
+
+// BridgeType is a capture of the model representing the HTTP option columns
+// introduced by this migration.
+type BridgeType struct {
+	Name           string `gorm:"primary_key"`
+	Timeout        int64  `gorm:"not null;default:0"`
+	ClientCertPath string
+	ClientKeyPath  string
+	CABundlePath   string
+	ProxyURL       string
+}
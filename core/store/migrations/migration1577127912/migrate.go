@@ -0,0 +1,15 @@
+package migration1577127912
+
+import (
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// Migrate adds the Condition column to task_specs, allowing tasks to be
+// skipped depending on the result of another task.
+func Migrate(tx *gorm.DB) error {
+	if err := tx.Exec(`ALTER TABLE task_specs ADD COLUMN "condition" varchar(255) NOT NULL DEFAULT '';`).Error; err != nil {
+		return errors.Wrap(err, "could not add condition to task_specs")
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+package migration1577214312
+
+import (
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// Migrate indexes the columns job runs are searched by.
+func Migrate(tx *gorm.DB) error {
+	for _, index := range []string{
+		`CREATE INDEX idx_job_runs_finished_at ON job_runs ("finished_at");`,
+		`CREATE INDEX idx_job_runs_initiator_id ON job_runs ("initiator_id");`,
+		`CREATE INDEX idx_job_runs_run_request_id ON job_runs ("run_request_id");`,
+		`CREATE INDEX idx_job_runs_result_id ON job_runs ("result_id");`,
+		`CREATE INDEX idx_run_requests_request_id ON run_requests ("request_id");`,
+		`CREATE INDEX idx_run_requests_tx_hash ON run_requests ("tx_hash");`,
+		`CREATE INDEX idx_run_requests_requester ON run_requests ("requester");`,
+	} {
+		if err := tx.Exec(index).Error; err != nil {
+			return errors.Wrap(err, "could not index job runs")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package migration1577300712
+
+import (
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// Migrate creates the bulk_delete_run_tasks table, tracking the deletion of
+// runs in the background, and the bulk_delete_run_requests table holding the
+// query of each task.
+func Migrate(tx *gorm.DB) error {
+	if err := tx.AutoMigrate(&BulkDeleteRunTask{}).Error; err != nil {
+		return errors.Wrap(err, "failed to auto migrate BulkDeleteRunTask")
+	}
+	if err := tx.AutoMigrate(&BulkDeleteRunRequest{}).Error; err != nil {
+		return errors.Wrap(err, "failed to auto migrate BulkDeleteRunRequest")
+	}
+	return nil
+}
+
+// BulkDeleteRunTask is a capture of the model representing a bulk deletion
+// in progress.
+type BulkDeleteRunTask struct {
+	ID        string `gorm:"primary_key;not null;type:varchar(36)"`
+	Status    string `gorm:"index"`
+	Error     string `gorm:"type:text"`
+	Total     int
+	Deleted   int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// BulkDeleteRunRequest is a capture of the model representing the query of a
+// bulk deletion.
+type BulkDeleteRunRequest struct {
+	ID                  uint   `gorm:"primary_key"`
+	BulkDeleteRunTaskID string `gorm:"index;not null;type:varchar(36) REFERENCES bulk_delete_run_tasks(id) ON DELETE CASCADE"`
+	Status              string `gorm:"type:text"`
+	UpdatedBefore       time.Time
+	JobSpecID           *string `gorm:"type:varchar(36)"`
+}
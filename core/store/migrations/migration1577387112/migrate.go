@@ -0,0 +1,50 @@
+package migration1577387112
+
+import (
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// Migrate creates the audit_log_entries table, with triggers refusing to
+// update or delete its rows so that the log can only be appended to.
+func Migrate(tx *gorm.DB) error {
+	if err := tx.AutoMigrate(&AuditLogEntry{}).Error; err != nil {
+		return errors.Wrap(err, "failed to auto migrate AuditLogEntry")
+	}
+
+	triggers := []string{
+		`CREATE TRIGGER audit_log_entries_no_update BEFORE UPDATE ON audit_log_entries
+		BEGIN SELECT RAISE(ABORT, 'audit log entries cannot be changed'); END;`,
+		`CREATE TRIGGER audit_log_entries_no_delete BEFORE DELETE ON audit_log_entries
+		BEGIN SELECT RAISE(ABORT, 'audit log entries cannot be changed'); END;`,
+	}
+	if tx.Dialect().GetName() == "postgres" {
+		triggers = []string{
+			`CREATE FUNCTION audit_log_entries_append_only() RETURNS trigger AS $$
+			BEGIN RAISE EXCEPTION 'audit log entries cannot be changed'; END;
+			$$ LANGUAGE plpgsql;`,
+			`CREATE TRIGGER audit_log_entries_append_only BEFORE UPDATE OR DELETE ON audit_log_entries
+			FOR EACH ROW EXECUTE PROCEDURE audit_log_entries_append_only();`,
+		}
+	}
+	for _, trigger := range triggers {
+		if err := tx.Exec(trigger).Error; err != nil {
+			return errors.Wrap(err, "could not make audit_log_entries append only")
+		}
+	}
+	return nil
+}
+
+// AuditLogEntry is a capture of the model representing an entry of the audit
+// log.
+type AuditLogEntry struct {
+	ID        uint64 `gorm:"primary_key;auto_increment"`
+	ActorType string `gorm:"not null"`
+	Actor     string `gorm:"not null"`
+	Action    string `gorm:"not null;index"`
+	Target    string
+	Payload   string    `gorm:"type:text"`
+	CreatedAt time.Time `gorm:"index"`
+}
@@ -0,0 +1,25 @@
+package migration1577473512
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// Migrate adds the parent hash to heads, so that the head tracker can follow
+// the chain and notice reorgs. Heads saved before have an empty parent hash.
+func Migrate(tx *gorm.DB) error {
+	if err := tx.AutoMigrate(&Head{}).Error; err != nil {
+		return errors.Wrap(err, "failed to auto migrate Head")
+	}
+	err := tx.Exec(`UPDATE heads SET parent_hash = ? WHERE parent_hash IS NULL`, common.Hash{}).Error
+	return errors.Wrap(err, "failed to set parent hash of heads")
+}
+
+// Head is a capture of the model representing heads
+type Head struct {
+	ID         uint64      `gorm:"primary_key;auto_increment"`
+	Hash       common.Hash `gorm:"not null"`
+	ParentHash common.Hash
+	Number     int64 `gorm:"index;not null"`
+}
@@ -0,0 +1,51 @@
+package migration1577559912
+
+import (
+	"time"
+
+	"chainlink/core/assets"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// Migrate adds the log index and initiator to run requests, unique for each
+// log so that a log cannot request two runs, and creates the log_cursors
+// table tracking how far the logs of each initiator have been processed.
+func Migrate(tx *gorm.DB) error {
+	if err := tx.AutoMigrate(&RunRequest{}).Error; err != nil {
+		return errors.Wrap(err, "failed to auto migrate RunRequest")
+	}
+	err := tx.Model(&RunRequest{}).
+		AddUniqueIndex("idx_run_requests_log", "tx_hash", "block_hash", "log_index", "initiator_id").
+		Error
+	if err != nil {
+		return errors.Wrap(err, "failed to add unique index to run_requests")
+	}
+	if err := tx.AutoMigrate(&LogCursor{}).Error; err != nil {
+		return errors.Wrap(err, "failed to auto migrate LogCursor")
+	}
+	return nil
+}
+
+// RunRequest is a capture of the model representing run requests
+type RunRequest struct {
+	ID          uint         `gorm:"primary_key"`
+	RequestID   *string      `gorm:"index"`
+	TxHash      *common.Hash `gorm:"index"`
+	BlockHash   *common.Hash
+	LogIndex    *uint
+	InitiatorID *uint
+	Requester   *common.Address `gorm:"index"`
+	CreatedAt   time.Time
+	Payment     *assets.Link
+}
+
+// LogCursor is a capture of the model representing log cursors
+type LogCursor struct {
+	InitiatorID uint  `gorm:"primary_key;auto_increment:false"`
+	BlockNumber int64 `gorm:"not null"`
+	LogIndex    int64 `gorm:"not null"`
+	UpdatedAt   time.Time
+}
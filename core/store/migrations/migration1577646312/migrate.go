@@ -0,0 +1,36 @@
+package migration1577646312
+
+import (
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// Migrate adds the job to run requests and keys the log of each run request
+// on the job instead of the initiator, since updating a job replaces its
+// initiators. Of run requests already made by one log for one job, only the
+// first is keyed.
+func Migrate(tx *gorm.DB) error {
+	if err := tx.Exec(`ALTER TABLE run_requests ADD COLUMN "job_spec_id" varchar(36);`).Error; err != nil {
+		return errors.Wrap(err, "could not add job_spec_id to run_requests")
+	}
+	err := tx.Exec(`
+UPDATE run_requests
+SET job_spec_id = (SELECT initiators.job_spec_id FROM initiators WHERE initiators.id = run_requests.initiator_id)
+WHERE id IN (
+	SELECT MIN(rr.id) FROM run_requests rr
+	JOIN initiators i ON i.id = rr.initiator_id
+	WHERE rr.log_index IS NOT NULL
+	GROUP BY i.job_spec_id, rr.tx_hash, rr.block_hash, rr.log_index
+);`).Error
+	if err != nil {
+		return errors.Wrap(err, "could not backfill job_spec_id of run_requests")
+	}
+	if err := tx.Exec(`DROP INDEX idx_run_requests_log;`).Error; err != nil {
+		return errors.Wrap(err, "could not drop idx_run_requests_log")
+	}
+	err = tx.Exec(`CREATE UNIQUE INDEX idx_run_requests_job_log ON run_requests (job_spec_id, tx_hash, block_hash, log_index);`).Error
+	if err != nil {
+		return errors.Wrap(err, "could not add idx_run_requests_job_log")
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+package models
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AuditActorType is how the actor behind an audited action authenticated.
+type AuditActorType string
+
+const (
+	// AuditActorSession is a user signed in with a session cookie.
+	AuditActorSession = AuditActorType("session")
+	// AuditActorAPIToken is a user authenticated by their API token.
+	AuditActorAPIToken = AuditActorType("api_token")
+	// AuditActorExternalInitiator is an external initiator.
+	AuditActorExternalInitiator = AuditActorType("external_initiator")
+)
+
+// AuditAction names an administrative action recorded in the audit log.
+type AuditAction string
+
+const (
+	// AuditJobCreated is recorded when a job is created.
+	AuditJobCreated = AuditAction("job.created")
+	// AuditJobUpdated is recorded when a new version of a job is saved.
+	AuditJobUpdated = AuditAction("job.updated")
+	// AuditJobArchived is recorded when a job is archived.
+	AuditJobArchived = AuditAction("job.archived")
+	// AuditJobBundleImported is recorded when a bundle of jobs is imported.
+	AuditJobBundleImported = AuditAction("job_bundle.imported")
+	// AuditRunCreated is recorded when a run is started through the API.
+	AuditRunCreated = AuditAction("run.created")
+	// AuditRunCancelled is recorded when a run is cancelled.
+	AuditRunCancelled = AuditAction("run.cancelled")
+	// AuditRunsBulkDeleted is recorded when runs are deleted in bulk.
+	AuditRunsBulkDeleted = AuditAction("runs.bulk_deleted")
+	// AuditBridgeCreated is recorded when a bridge is created.
+	AuditBridgeCreated = AuditAction("bridge.created")
+	// AuditBridgeUpdated is recorded when a bridge is changed.
+	AuditBridgeUpdated = AuditAction("bridge.updated")
+	// AuditBridgeSecretRotated is recorded when the signing secret of a
+	// bridge is replaced.
+	AuditBridgeSecretRotated = AuditAction("bridge.secret_rotated")
+	// AuditBridgeDeleted is recorded when a bridge is removed.
+	AuditBridgeDeleted = AuditAction("bridge.deleted")
+	// AuditExternalInitiatorCreated is recorded when an external initiator is
+	// created.
+	AuditExternalInitiatorCreated = AuditAction("external_initiator.created")
+	// AuditExternalInitiatorDeleted is recorded when an external initiator is
+	// removed.
+	AuditExternalInitiatorDeleted = AuditAction("external_initiator.deleted")
+	// AuditConfigUpdated is recorded when the configuration is changed.
+	AuditConfigUpdated = AuditAction("config.updated")
+	// AuditKeyCreated is recorded when an Ethereum key is created.
+	AuditKeyCreated = AuditAction("key.created")
+	// AuditLinkWithdrawn is recorded when LINK is withdrawn from the oracle
+	// contract.
+	AuditLinkWithdrawn = AuditAction("link.withdrawn")
+	// AuditEthTransferred is recorded when ETH is sent from a node account.
+	AuditEthTransferred = AuditAction("eth.transferred")
+	// AuditPasswordChanged is recorded when the user's password is changed.
+	AuditPasswordChanged = AuditAction("user.password_changed")
+	// AuditAPITokenCreated is recorded when the user's API token is created
+	// or replaced.
+	AuditAPITokenCreated = AuditAction("user.api_token_created")
+	// AuditAPITokenDeleted is recorded when the user's API token is deleted.
+	AuditAPITokenDeleted = AuditAction("user.api_token_deleted")
+)
+
+// AuditLogEntry records an administrative action: who carried it out, what
+// they did, what it was done to, and the request that did it. Entries are
+// only ever appended to the log.
+type AuditLogEntry struct {
+	ID        uint64         `json:"-" gorm:"primary_key;auto_increment"`
+	ActorType AuditActorType `json:"actorType" gorm:"not null"`
+	Actor     string         `json:"actor" gorm:"not null"`
+	Action    AuditAction    `json:"action" gorm:"not null;index"`
+	Target    string         `json:"target"`
+	Payload   JSON           `json:"payload" gorm:"type:text"`
+	CreatedAt time.Time      `json:"createdAt" gorm:"index"`
+}
+
+// NewAuditLogEntry returns an entry for the action, with its payload
+// serialized and redacted.
+func NewAuditLogEntry(actorType AuditActorType, actor string, action AuditAction, target string, payload interface{}) (AuditLogEntry, error) {
+	entry := AuditLogEntry{
+		ActorType: actorType,
+		Actor:     actor,
+		Action:    action,
+		Target:    target,
+	}
+	if payload == nil {
+		return entry, nil
+	}
+
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return entry, err
+	}
+	entry.Payload, err = RedactJSON(b)
+	return entry, err
+}
+
+// GetID returns the ID of this structure for jsonapi serialization.
+func (e AuditLogEntry) GetID() string {
+	return strconv.FormatUint(e.ID, 10)
+}
+
+// GetName returns the pluralized "type" of this structure for jsonapi serialization.
+func (e AuditLogEntry) GetName() string {
+	return "auditLogEntries"
+}
+
+// SetID is used to set the ID of this structure when deserializing from jsonapi documents.
+func (e *AuditLogEntry) SetID(value string) error {
+	id, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return err
+	}
+	e.ID = id
+	return nil
+}
+
+// Redacted replaces the values of sensitive fields in audit log payloads.
+const Redacted = "[REDACTED]"
+
+// redactedFields are the substrings of the names of the fields whose values
+// are redacted, matched regardless of case, underscores and dashes. Fields
+// whose names end in "key" are redacted as well.
+var redactedFields = []string{"password", "secret", "token", "privatekey", "authorization", "apikey", "accesskey"}
+
+// RedactJSON parses a JSON document, replacing the value of every field whose
+// name looks like it holds a credential with Redacted, at any depth.
+func RedactJSON(b []byte) (JSON, error) {
+	var value interface{}
+	if err := json.Unmarshal(b, &value); err != nil {
+		return JSON{}, err
+	}
+
+	redacted, err := json.Marshal(redact(value))
+	if err != nil {
+		return JSON{}, err
+	}
+	return ParseJSON(redacted)
+}
+
+func redact(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if redactedField(key) {
+				v[key] = Redacted
+			} else {
+				v[key] = redact(field)
+			}
+		}
+	case []interface{}:
+		for i, element := range v {
+			v[i] = redact(element)
+		}
+	}
+	return value
+}
+
+func redactedField(name string) bool {
+	name = strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(name))
+	if strings.HasSuffix(name, "key") {
+		return true
+	}
+	for _, field := range redactedFields {
+		if strings.Contains(name, field) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,83 @@
+package models_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"chainlink/core/store/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactJSON(t *testing.T) {
+	t.Parallel()
+
+	redacted, err := models.RedactJSON([]byte(`{
+		"name": "bridge",
+		"currentPassword": "hunter2",
+		"outgoingToken": "abc",
+		"nested": {"signingSecret": "def", "url": "http://example.com"},
+		"list": [{"privateKey": "0x01", "value": 1}],
+		"Authorization": "Bearer abc",
+		"x-api-key": "def",
+		"access_key": "ghi",
+		"signingKey": "jkl",
+		"keys": 2
+	}`))
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"name": "bridge",
+		"currentPassword": "[REDACTED]",
+		"outgoingToken": "[REDACTED]",
+		"nested": {"signingSecret": "[REDACTED]", "url": "http://example.com"},
+		"list": [{"privateKey": "[REDACTED]", "value": 1}],
+		"Authorization": "[REDACTED]",
+		"x-api-key": "[REDACTED]",
+		"access_key": "[REDACTED]",
+		"signingKey": "[REDACTED]",
+		"keys": 2
+	}`, redacted.String())
+
+	_, err = models.RedactJSON([]byte(`{`))
+	assert.Error(t, err)
+}
+
+func TestNewAuditLogEntry(t *testing.T) {
+	t.Parallel()
+
+	payload := models.CreateKeyRequest{CurrentPassword: "hunter2"}
+	entry, err := models.NewAuditLogEntry(models.AuditActorAPIToken, "user@example.com", models.AuditKeyCreated, "0x01", payload)
+	require.NoError(t, err)
+	assert.Equal(t, models.AuditActorAPIToken, entry.ActorType)
+	assert.Equal(t, "user@example.com", entry.Actor)
+	assert.Equal(t, models.AuditKeyCreated, entry.Action)
+	assert.Equal(t, "0x01", entry.Target)
+	assert.Equal(t, models.Redacted, entry.Payload.Get("current_password").String())
+
+	entry, err = models.NewAuditLogEntry(models.AuditActorSession, "user@example.com", models.AuditJobArchived, "1", nil)
+	require.NoError(t, err)
+	assert.False(t, entry.Payload.Exists())
+}
+
+func TestNewAuditLogEntry_HTTPGetHeaders(t *testing.T) {
+	t.Parallel()
+
+	var request models.JobSpecRequest
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"initiators": [{"type": "web"}],
+		"tasks": [{"type": "httpget", "params": {
+			"get": "https://example.com/api",
+			"headers": {"Authorization": ["Bearer abc"], "X-API-Key": ["def"], "Accept": ["application/json"]}
+		}}]
+	}`), &request))
+
+	entry, err := models.NewAuditLogEntry(models.AuditActorSession, "user@example.com", models.AuditJobCreated, "1", request)
+	require.NoError(t, err)
+	headers := entry.Payload.Get("tasks.0.params.headers")
+	assert.Equal(t, models.Redacted, headers.Get("Authorization").String())
+	assert.Equal(t, models.Redacted, headers.Get("X-API-Key").String())
+	assert.Equal(t, "application/json", headers.Get("Accept.0").String())
+	assert.Equal(t, "https://example.com/api", entry.Payload.Get("tasks.0.params.get").String())
+}
@@ -14,6 +14,7 @@ type BridgeRunResult struct {
 	ErrorMessage    null.String `json:"error"`
 	ExternalPending bool        `json:"pending"`
 	AccessToken     string      `json:"accessToken"`
+	TaskRunID       *ID         `json:"taskRunId,omitempty"`
 }
 
 // UnmarshalJSON parses the given input and updates the BridgeRunResult in the
@@ -1,11 +1,30 @@
 package models
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
 	"crypto/subtle"
+	"encoding/hex"
 	"fmt"
+	"strconv"
+	"time"
 
 	"chainlink/core/assets"
 	"chainlink/core/utils"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// BridgeTimestampHeader is the header holding the unix time at which a
+	// signed bridge request or callback was sent.
+	BridgeTimestampHeader = "X-Chainlink-Bridge-Timestamp"
+	// BridgeSignatureHeader is the header holding the hex encoded HMAC-SHA256
+	// of a signed bridge request or callback.
+	BridgeSignatureHeader = "X-Chainlink-Bridge-Signature"
+	// BridgeSignatureTolerance is how far the timestamp of a signed callback
+	// may be from the node's clock, limiting how long it can be replayed.
+	BridgeSignatureTolerance = 5 * time.Minute
 )
 
 // BridgeTypeRequest is the incoming record used to create a BridgeType
@@ -14,6 +33,22 @@ type BridgeTypeRequest struct {
 	URL                    WebURL       `json:"url"`
 	Confirmations          uint32       `json:"confirmations"`
 	MinimumContractPayment *assets.Link `json:"minimumContractPayment"`
+	// Signed enables signing of requests to the bridge, and requires its
+	// callbacks to be signed. Updates leave signing as it is when unset.
+	Signed *bool `json:"signed,omitempty"`
+	// RotateSigningSecret replaces the secret of a signed bridge.
+	RotateSigningSecret bool `json:"rotateSigningSecret"`
+	BridgeHTTPOptions
+}
+
+// BridgeHTTPOptions overrides the node's outbound HTTP configuration for
+// requests to a bridge. Paths are to PEM files on the node.
+type BridgeHTTPOptions struct {
+	Timeout        Duration `json:"timeout,omitempty" gorm:"not null;default:0"`
+	ClientCertPath string   `json:"clientCertPath,omitempty"`
+	ClientKeyPath  string   `json:"clientKeyPath,omitempty"`
+	CABundlePath   string   `json:"caBundlePath,omitempty"`
+	ProxyURL       string   `json:"proxyURL,omitempty"`
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -40,6 +75,7 @@ type BridgeTypeAuthentication struct {
 	Confirmations          uint32       `json:"confirmations"`
 	IncomingToken          string       `json:"incomingToken"`
 	OutgoingToken          string       `json:"outgoingToken"`
+	SigningSecret          string       `json:"signingSecret,omitempty"`
 	MinimumContractPayment *assets.Link `json:"minimumContractPayment"`
 }
 
@@ -69,7 +105,9 @@ type BridgeType struct {
 	IncomingTokenHash      string       `json:"-"`
 	Salt                   string       `json:"-"`
 	OutgoingToken          string       `json:"outgoingToken"`
+	SigningSecret          string       `json:"signingSecret,omitempty"`
 	MinimumContractPayment *assets.Link `json:"minimumContractPayment" gorm:"type:varchar(255)"`
+	BridgeHTTPOptions
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -102,12 +140,18 @@ func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
 		return nil, nil, err
 	}
 
+	var signingSecret string
+	if btr.Signed != nil && *btr.Signed {
+		signingSecret = utils.NewSecret(32)
+	}
+
 	return &BridgeTypeAuthentication{
 			Name:                   btr.Name,
 			URL:                    btr.URL,
 			Confirmations:          btr.Confirmations,
 			IncomingToken:          incomingToken,
 			OutgoingToken:          outgoingToken,
+			SigningSecret:          signingSecret,
 			MinimumContractPayment: btr.MinimumContractPayment,
 		}, &BridgeType{
 			Name:                   btr.Name,
@@ -116,10 +160,70 @@ func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
 			IncomingTokenHash:      hash,
 			Salt:                   salt,
 			OutgoingToken:          outgoingToken,
+			SigningSecret:          signingSecret,
 			MinimumContractPayment: btr.MinimumContractPayment,
+			BridgeHTTPOptions:      btr.BridgeHTTPOptions,
 		}, nil
 }
 
+// UpdateSigning enables or disables signing for the bridge as requested,
+// generating a new secret when it is first enabled or rotated. Signing is
+// left as it is when the request doesn't set Signed.
+func (bt *BridgeType) UpdateSigning(btr *BridgeTypeRequest) {
+	switch {
+	case btr.RotateSigningSecret:
+		bt.SigningSecret = utils.NewSecret(32)
+	case btr.Signed == nil:
+	case !*btr.Signed:
+		bt.SigningSecret = ""
+	case bt.SigningSecret == "":
+		bt.SigningSecret = utils.NewSecret(32)
+	}
+}
+
+// Signed returns true if requests to the bridge and its callbacks are signed.
+func (bt *BridgeType) Signed() bool {
+	return bt.SigningSecret != ""
+}
+
+// SignBridgeMessage returns the signature of a bridge request or callback
+// body for the given job run and task run, sent at the given unix time. The
+// task run ID is empty in the signed material when taskRunID is nil.
+func SignBridgeMessage(secret string, timestamp string, runID, taskRunID *ID, body []byte) string {
+	var task string
+	if taskRunID != nil {
+		task = taskRunID.String()
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "." + runID.String() + "." + task + "."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyBridgeSignature returns an error unless the signature of a callback
+// from a signed bridge matches its run, task run and body, and its timestamp
+// is recent. Callbacks from bridges that aren't signed are always accepted.
+func VerifyBridgeSignature(bt *BridgeType, runID, taskRunID *ID, timestamp, signature string, body []byte, now time.Time) error {
+	if !bt.Signed() {
+		return nil
+	}
+
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid bridge signature timestamp")
+	}
+	sentAt := time.Unix(unix, 0)
+	if sentAt.Before(now.Add(-BridgeSignatureTolerance)) || sentAt.After(now.Add(BridgeSignatureTolerance)) {
+		return errors.New("bridge signature timestamp is outside the allowed window")
+	}
+
+	expected := SignBridgeMessage(bt.SigningSecret, timestamp, runID, taskRunID, body)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return errors.New("invalid bridge signature")
+	}
+	return nil
+}
+
 // AuthenticateBridgeType returns true if the passed token matches its
 // IncomingToken, or returns false with an error.
 func AuthenticateBridgeType(bt *BridgeType, token string) (bool, error) {
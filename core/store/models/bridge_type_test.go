@@ -1,7 +1,9 @@
 package models_test
 
 import (
+	"strconv"
 	"testing"
+	"time"
 
 	"chainlink/core/internal/cltest"
 	"chainlink/core/store/models"
@@ -36,3 +38,70 @@ func TestBridgeType_Authenticate(t *testing.T) {
 		})
 	}
 }
+
+func TestBridgeType_UpdateSigning(t *testing.T) {
+	t.Parallel()
+
+	signed, unsigned := true, false
+	_, bt := cltest.NewBridgeType(t)
+	assert.False(t, bt.Signed())
+
+	bt.UpdateSigning(&models.BridgeTypeRequest{Signed: &signed})
+	require.True(t, bt.Signed())
+	secret := bt.SigningSecret
+
+	bt.UpdateSigning(&models.BridgeTypeRequest{Signed: &signed})
+	assert.Equal(t, secret, bt.SigningSecret)
+
+	bt.UpdateSigning(&models.BridgeTypeRequest{})
+	assert.Equal(t, secret, bt.SigningSecret)
+
+	bt.UpdateSigning(&models.BridgeTypeRequest{RotateSigningSecret: true})
+	assert.True(t, bt.Signed())
+	assert.NotEqual(t, secret, bt.SigningSecret)
+
+	bt.UpdateSigning(&models.BridgeTypeRequest{Signed: &unsigned})
+	assert.False(t, bt.Signed())
+}
+
+func TestVerifyBridgeSignature(t *testing.T) {
+	t.Parallel()
+
+	enabled := true
+	_, unsigned := cltest.NewBridgeType(t)
+	_, signed, err := models.NewBridgeType(&models.BridgeTypeRequest{
+		Name:   models.MustNewTaskType("signedbridge"),
+		Signed: &enabled,
+	})
+	require.NoError(t, err)
+
+	runID, taskRunID := models.NewID(), models.NewID()
+	body := []byte(`{"data":{"result":"100"}}`)
+	now := time.Now()
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	stale := strconv.FormatInt(now.Add(-models.BridgeSignatureTolerance-time.Second).Unix(), 10)
+
+	tests := []struct {
+		name      string
+		bt        *models.BridgeType
+		timestamp string
+		signature string
+		wantError bool
+	}{
+		{"unsigned bridge", unsigned, "", "", false},
+		{"valid", signed, timestamp, models.SignBridgeMessage(signed.SigningSecret, timestamp, runID, taskRunID, body), false},
+		{"missing", signed, "", "", true},
+		{"wrong secret", signed, timestamp, models.SignBridgeMessage("wrong", timestamp, runID, taskRunID, body), true},
+		{"other run", signed, timestamp, models.SignBridgeMessage(signed.SigningSecret, timestamp, models.NewID(), taskRunID, body), true},
+		{"other task run", signed, timestamp, models.SignBridgeMessage(signed.SigningSecret, timestamp, runID, models.NewID(), body), true},
+		{"no task run", signed, timestamp, models.SignBridgeMessage(signed.SigningSecret, timestamp, runID, nil, body), true},
+		{"stale", signed, stale, models.SignBridgeMessage(signed.SigningSecret, stale, runID, taskRunID, body), true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := models.VerifyBridgeSignature(test.bt, runID, taskRunID, test.timestamp, test.signature, body, now)
+			cltest.AssertError(t, test.wantError, err)
+		})
+	}
+}
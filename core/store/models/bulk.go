@@ -7,17 +7,21 @@ import (
 	"time"
 )
 
-// BulkDeleteRunRequest describes the query for deletion of runs
+// BulkDeleteRunRequest describes the query for deletion of runs. Runs are
+// deleted when they are in one of the statuses, were last updated before
+// UpdatedBefore, and, when JobSpecID is set, belong to that job.
 type BulkDeleteRunRequest struct {
-	ID            uint                `gorm:"primary_key"`
-	Status        RunStatusCollection `json:"status" gorm:"type:text"`
-	UpdatedBefore time.Time           `json:"updatedBefore"`
+	ID                  uint                `json:"-" gorm:"primary_key"`
+	BulkDeleteRunTaskID *ID                 `json:"-"`
+	Status              RunStatusCollection `json:"status" gorm:"type:text"`
+	UpdatedBefore       time.Time           `json:"updatedBefore"`
+	JobSpecID           *ID                 `json:"jobSpecId,omitempty"`
 }
 
 // ValidateBulkDeleteRunRequest returns a task from a request to make a task
 func ValidateBulkDeleteRunRequest(request *BulkDeleteRunRequest) error {
 	for _, status := range request.Status {
-		if status != RunStatusCompleted && status != RunStatusErrored {
+		if status != RunStatusCompleted && status != RunStatusErrored && status != RunStatusCancelled {
 			return fmt.Errorf("cannot delete Runs with status %s", status)
 		}
 	}
@@ -25,6 +29,60 @@ func ValidateBulkDeleteRunRequest(request *BulkDeleteRunRequest) error {
 	return nil
 }
 
+// BulkTaskStatus is the status of a task deleting resources in the
+// background.
+type BulkTaskStatus string
+
+const (
+	// BulkTaskStatusInProgress is the status of a task still deleting.
+	BulkTaskStatusInProgress = BulkTaskStatus("in_progress")
+	// BulkTaskStatusErrored is the status of a task that stopped on an error.
+	BulkTaskStatusErrored = BulkTaskStatus("errored")
+	// BulkTaskStatusCompleted is the status of a task that deleted everything
+	// its query matched.
+	BulkTaskStatusCompleted = BulkTaskStatus("completed")
+)
+
+// BulkDeleteRunTask tracks the deletion of the runs matching a query, which
+// is carried out in batches in the background. Total is the number of runs
+// the query matched when the task was created, and Deleted the number deleted
+// so far.
+type BulkDeleteRunTask struct {
+	ID        *ID                  `json:"id" gorm:"primary_key;not null"`
+	Query     BulkDeleteRunRequest `json:"query"`
+	Status    BulkTaskStatus       `json:"status"`
+	Error     string               `json:"error,omitempty" gorm:"type:text"`
+	Total     int                  `json:"total"`
+	Deleted   int                  `json:"deleted"`
+	CreatedAt time.Time            `json:"createdAt"`
+	UpdatedAt time.Time            `json:"updatedAt"`
+}
+
+// NewBulkDeleteRunTask returns a task in progress deleting the runs matching
+// the request.
+func NewBulkDeleteRunTask(request BulkDeleteRunRequest) *BulkDeleteRunTask {
+	return &BulkDeleteRunTask{
+		ID:     NewID(),
+		Query:  request,
+		Status: BulkTaskStatusInProgress,
+	}
+}
+
+// GetID returns the ID of this structure for jsonapi serialization.
+func (t BulkDeleteRunTask) GetID() string {
+	return t.ID.String()
+}
+
+// GetName returns the pluralized "type" of this structure for jsonapi serialization.
+func (t BulkDeleteRunTask) GetName() string {
+	return "bulkDeleteRunTasks"
+}
+
+// SetID is used to set the ID of this structure when deserializing from jsonapi documents.
+func (t *BulkDeleteRunTask) SetID(value string) error {
+	return t.ID.UnmarshalText([]byte(value))
+}
+
 // RunStatusCollection is an array of RunStatus.
 type RunStatusCollection []RunStatus
 
@@ -17,6 +17,11 @@ func TestBulkDeleteRunTask_ValidateBulkDeleteRunRequest(t *testing.T) {
 	})
 	assert.NoError(t, err)
 
+	err = models.ValidateBulkDeleteRunRequest(&models.BulkDeleteRunRequest{
+		Status: []models.RunStatus{models.RunStatusErrored, models.RunStatusCancelled},
+	})
+	assert.NoError(t, err)
+
 	err = models.ValidateBulkDeleteRunRequest(&models.BulkDeleteRunRequest{
 		Status: []models.RunStatus{""},
 	})
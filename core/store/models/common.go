@@ -44,6 +44,9 @@ const (
 	RunStatusCompleted = RunStatus("completed")
 	// RunStatusCancelled is used to indicate a run is no longer desired.
 	RunStatusCancelled = RunStatus("cancelled")
+	// RunStatusSkipped is used for when a task was not run because its
+	// condition was not met.
+	RunStatusSkipped = RunStatus("skipped")
 )
 
 // Unstarted returns true if the status is the initial state.
@@ -81,6 +84,11 @@ func (s RunStatus) Cancelled() bool {
 	return s == RunStatusCancelled
 }
 
+// Skipped returns true if the status is RunStatusSkipped.
+func (s RunStatus) Skipped() bool {
+	return s == RunStatusSkipped
+}
+
 // Errored returns true if the status is RunStatusErrored.
 func (s RunStatus) Errored() bool {
 	return s == RunStatusErrored
@@ -93,7 +101,7 @@ func (s RunStatus) Pending() bool {
 
 // Finished returns true if the status is final and can't be changed.
 func (s RunStatus) Finished() bool {
-	return s.Completed() || s.Errored() || s.Cancelled()
+	return s.Completed() || s.Errored() || s.Cancelled() || s.Skipped()
 }
 
 // Runnable returns true if the status is ready to be run.
@@ -401,6 +409,59 @@ func (c Cron) String() string {
 	return string(c)
 }
 
+// Duration is a time.Duration that is serialized as a string such as "1m30s".
+type Duration time.Duration
+
+// Duration returns the value as the standard time.Duration value.
+func (d Duration) Duration() time.Duration {
+	return time.Duration(d)
+}
+
+// String returns a string representing the duration in the form "72h3m0.5s".
+func (d Duration) String() string {
+	return time.Duration(d).String()
+}
+
+// Value returns the duration in nanoseconds for database storage.
+func (d Duration) Value() (driver.Value, error) {
+	return int64(d), nil
+}
+
+// Scan reads the database value and returns an instance.
+func (d *Duration) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case int64:
+		*d = Duration(v)
+	case nil:
+		*d = 0
+	default:
+		return fmt.Errorf("unable to convert %v of %T to Duration", value, value)
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON parses a duration string such as "300ms" or "1h30m".
+func (d *Duration) UnmarshalJSON(input []byte) error {
+	var str string
+	if err := json.Unmarshal(input, &str); err != nil {
+		return fmt.Errorf("unable to parse duration %s: %v", input, err)
+	}
+	duration, err := time.ParseDuration(str)
+	if err != nil {
+		return err
+	}
+	if duration < 0 {
+		return fmt.Errorf("duration cannot be negative: %s", str)
+	}
+	*d = Duration(duration)
+	return nil
+}
+
 // WithdrawalRequest request to withdraw LINK.
 type WithdrawalRequest struct {
 	DestinationAddress common.Address `json:"address"`
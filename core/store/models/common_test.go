@@ -348,3 +348,36 @@ func TestAnyTime_MarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestDuration_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		want    models.Duration
+		errored bool
+	}{
+		{"seconds", `"30s"`, models.Duration(30 * time.Second), false},
+		{"compound", `"1h30m"`, models.Duration(90 * time.Minute), false},
+		{"negative", `"-1s"`, 0, true},
+		{"number", `30`, 0, true},
+		{"garbage", `"soon"`, 0, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var actual models.Duration
+			err := json.Unmarshal([]byte(test.input), &actual)
+			if test.errored {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, test.want, actual)
+
+				b, err := json.Marshal(actual)
+				require.NoError(t, err)
+				assert.Equal(t, test.want.String(), string(b[1:len(b)-1]))
+			}
+		})
+	}
+}
@@ -36,4 +36,15 @@
 // i.e. We have a Scheduler Initiator that creates a JobRun every monday
 // based on a JobDefinition. And in turn, those JobRuns have TaskRuns based
 // on the JobDefinition's TaskDefinitions.
+//
+// TaskRuns normally run one after another, each taking the previous one's
+// result as its input. When tasks name their upstream tasks in "inputs",
+// they instead form a graph, and tasks whose inputs have completed run
+// concurrently:
+//
+//  "tasks": [
+//    {"taskId": "a", "type": "httpget", "params": {"get": "https://a.example"}},
+//    {"taskId": "b", "type": "httpget", "params": {"get": "https://b.example"}},
+//    {"taskId": "join", "type": "noop", "inputs": ["a", "b"]}
+//  ]
 package models
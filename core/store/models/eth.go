@@ -134,9 +134,10 @@ func HighestPricedTxAttemptPerTx(items []TxAttempt) []TxAttempt {
 
 // Head represents a BlockNumber, BlockHash.
 type Head struct {
-	ID     uint64      `gorm:"primary_key;auto_increment"`
-	Hash   common.Hash `gorm:"not null"`
-	Number int64       `gorm:"index;not null"`
+	ID         uint64      `gorm:"primary_key;auto_increment"`
+	Hash       common.Hash `gorm:"not null"`
+	ParentHash common.Hash
+	Number     int64 `gorm:"index;not null"`
 }
 
 // AfterCreate is a gorm hook that trims heads after its creation
@@ -203,6 +204,30 @@ func (l *Head) NextInt() *big.Int {
 	return new(big.Int).Add(l.ToInt(), big.NewInt(1))
 }
 
+// Reorg describes a chain reorganization seen by the head tracker: the heads
+// in Removed, running back from OldHead, were replaced by the chain ending at
+// NewHead.
+type Reorg struct {
+	// Depth is the number of blocks replaced on the old chain.
+	Depth int64
+	// CommonAncestor is the latest head shared by both chains, or nil when it
+	// is older than the heads the tracker keeps.
+	CommonAncestor *Head
+	OldHead        *Head
+	NewHead        *Head
+	// Removed are the heads no longer on the chain, newest first.
+	Removed []Head
+}
+
+// RemovedHashes returns the hashes of the heads no longer on the chain.
+func (r *Reorg) RemovedHashes() []common.Hash {
+	hashes := make([]common.Hash, len(r.Removed))
+	for i, head := range r.Removed {
+		hashes[i] = head.Hash
+	}
+	return hashes
+}
+
 // Key holds the private key metadata for a given address that is used to unlock
 // said key when given a password.
 type Key struct {
@@ -51,8 +51,12 @@ func (id *ID) UnmarshalString(input string) error {
 	return id.UnmarshalText([]byte(input))
 }
 
-// Value returns this instance serialized for database storage.
+// Value returns this instance serialized for database storage, or NULL if
+// there is no instance.
 func (id *ID) Value() (driver.Value, error) {
+	if id == nil {
+		return nil, nil
+	}
 	return id.String(), nil
 }
 
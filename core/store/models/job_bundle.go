@@ -0,0 +1,145 @@
+package models
+
+// JobBundle is a portable export of job specs, along with the bridges,
+// external initiators and service agreements they depend on, for importing
+// into another node.
+//
+// Bridges are bundled without their tokens and secrets, which are generated
+// anew on import. External initiators are only referenced by name, and must
+// already exist on the importing node.
+type JobBundle struct {
+	Jobs               []BundledJob        `json:"jobs"`
+	Bridges            []BridgeTypeRequest `json:"bridges"`
+	ExternalInitiators []string            `json:"externalInitiators"`
+	// ServiceAgreements holds the request each agreement was created from.
+	ServiceAgreements []JSON `json:"serviceAgreements"`
+}
+
+// GetID returns the ID of this structure for jsonapi serialization.
+func (JobBundle) GetID() string {
+	return ""
+}
+
+// GetName returns the pluralized "type" of this structure for jsonapi serialization.
+func (JobBundle) GetName() string {
+	return "job_bundles"
+}
+
+// SetID is used to set the ID of this structure when deserializing from jsonapi documents.
+func (*JobBundle) SetID(string) error {
+	return nil
+}
+
+// BundledJob is a job spec as it is exported in a JobBundle. It keeps the ID
+// of the job, so that importing the same job again leaves it unchanged.
+type BundledJob struct {
+	ID *ID `json:"id"`
+	JobSpecRequest
+}
+
+// NewBundledJob returns the job as it is exported in a JobBundle.
+func NewBundledJob(job JobSpec) BundledJob {
+	bj := BundledJob{
+		ID: job.ID,
+		JobSpecRequest: JobSpecRequest{
+			StartAt:    job.StartAt,
+			EndAt:      job.EndAt,
+			MinPayment: job.MinPayment,
+		},
+	}
+	bj.StartAt.Time = bj.StartAt.Time.UTC()
+	bj.EndAt.Time = bj.EndAt.Time.UTC()
+	for _, initr := range job.Initiators {
+		ir := InitiatorRequest{
+			Type:            initr.Type,
+			Name:            initr.Name,
+			InitiatorParams: initr.InitiatorParams,
+		}
+		ir.Time.Time = ir.Time.Time.UTC()
+		bj.Initiators = append(bj.Initiators, ir)
+	}
+	for _, task := range job.Tasks {
+		bj.Tasks = append(bj.Tasks, TaskSpecRequest{
+			Type:          task.Type,
+			TaskID:        task.TaskID,
+			Inputs:        task.Inputs,
+			Condition:     task.Condition,
+			Confirmations: task.Confirmations,
+			Timeout:       task.Timeout,
+			Params:        task.Params,
+		})
+	}
+	return bj
+}
+
+// JobSpec returns the job spec the bundled job was exported from.
+func (bj BundledJob) JobSpec() JobSpec {
+	job := NewJobFromRequest(bj.JobSpecRequest)
+	job.ID = bj.ID
+	for i := range job.Initiators {
+		job.Initiators[i].JobSpecID = bj.ID
+	}
+	for i := range job.Tasks {
+		job.Tasks[i].JobSpecID = bj.ID
+	}
+	return job
+}
+
+// NewBundledBridgeType returns the bridge as it is exported in a JobBundle.
+func NewBundledBridgeType(bt BridgeType) BridgeTypeRequest {
+	signed := bt.Signed()
+	return BridgeTypeRequest{
+		Name:                   bt.Name,
+		URL:                    bt.URL,
+		Confirmations:          bt.Confirmations,
+		MinimumContractPayment: bt.MinimumContractPayment,
+		Signed:                 &signed,
+		BridgeHTTPOptions:      bt.BridgeHTTPOptions,
+	}
+}
+
+// BundleImportStatus is the outcome of importing an item of a JobBundle.
+type BundleImportStatus string
+
+const (
+	// BundleImportCreated is the status of an item that was added to the node.
+	BundleImportCreated BundleImportStatus = "created"
+	// BundleImportUnchanged is the status of an item that the node already
+	// had, as it is in the bundle.
+	BundleImportUnchanged BundleImportStatus = "unchanged"
+)
+
+// BundleImportResult is the outcome of importing a job, bridge or service
+// agreement of a JobBundle.
+type BundleImportResult struct {
+	ID     string             `json:"id"`
+	Status BundleImportStatus `json:"status"`
+	// Bridge holds the tokens of a created bridge, which are needed to
+	// configure its external adapter.
+	Bridge *BridgeTypeAuthentication `json:"bridge,omitempty"`
+	// Error holds why a created job failed to start. The job is saved all
+	// the same, and starts when the node restarts.
+	Error string `json:"error,omitempty"`
+}
+
+// JobBundleImport reports the outcome of importing a JobBundle.
+type JobBundleImport struct {
+	Jobs              []BundleImportResult `json:"jobs"`
+	Bridges           []BundleImportResult `json:"bridges"`
+	ServiceAgreements []BundleImportResult `json:"serviceAgreements"`
+}
+
+// GetID returns the ID of this structure for jsonapi serialization.
+func (JobBundleImport) GetID() string {
+	return ""
+}
+
+// GetName returns the pluralized "type" of this structure for jsonapi serialization.
+func (JobBundleImport) GetName() string {
+	return "job_bundle_imports"
+}
+
+// SetID is used to set the ID of this structure when deserializing from jsonapi documents.
+func (*JobBundleImport) SetID(string) error {
+	return nil
+}
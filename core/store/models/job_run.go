@@ -9,6 +9,7 @@ import (
 	"chainlink/core/utils"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/tidwall/gjson"
 	null "gopkg.in/guregu/null.v3"
 )
 
@@ -17,17 +18,18 @@ import (
 type JobRun struct {
 	ID             *ID          `json:"id" gorm:"primary_key;not null"`
 	JobSpecID      *ID          `json:"jobId" gorm:"index;not null;type:varchar(36) REFERENCES job_specs(id)"`
+	JobSpecVersion uint32       `json:"jobVersion" gorm:"not null;default:1"`
 	Result         RunResult    `json:"result"`
-	ResultID       uint         `json:"-"`
+	ResultID       uint         `json:"-" gorm:"index"`
 	RunRequest     RunRequest   `json:"-"`
-	RunRequestID   uint         `json:"-"`
+	RunRequestID   uint         `json:"-" gorm:"index"`
 	Status         RunStatus    `json:"status" gorm:"index"`
 	TaskRuns       []TaskRun    `json:"taskRuns"`
 	CreatedAt      time.Time    `json:"createdAt" gorm:"index"`
-	FinishedAt     null.Time    `json:"finishedAt"`
+	FinishedAt     null.Time    `json:"finishedAt" gorm:"index"`
 	UpdatedAt      time.Time    `json:"updatedAt"`
 	Initiator      Initiator    `json:"initiator" gorm:"association_autoupdate:false;association_autocreate:false"`
-	InitiatorID    uint         `json:"-"`
+	InitiatorID    uint         `json:"-" gorm:"index"`
 	CreationHeight *utils.Big   `json:"creationHeight"`
 	ObservedHeight *utils.Big   `json:"observedHeight"`
 	Overrides      JSON         `json:"overrides"`
@@ -117,6 +119,217 @@ func (jr *JobRun) TasksRemain() bool {
 	return runnable
 }
 
+// HasTaskGraph returns true if the run's tasks declare inputs, and so are
+// executed as a graph rather than one after another.
+func (jr *JobRun) HasTaskGraph() bool {
+	for _, tr := range jr.TaskRuns {
+		if len(tr.TaskSpec.Inputs) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// TaskRunInputs returns the TaskRuns whose results are the input of the
+// TaskRun at the given position. Outside of a task graph, that is the
+// immediately preceding TaskRun.
+func (jr *JobRun) TaskRunInputs(index int) []*TaskRun {
+	if !jr.HasTaskGraph() {
+		if index > 0 {
+			return []*TaskRun{&jr.TaskRuns[index-1]}
+		}
+		return nil
+	}
+
+	inputs := []*TaskRun{}
+	for _, taskID := range jr.TaskRuns[index].TaskSpec.Inputs {
+		if input := jr.taskRunByTaskID(taskID); input != nil {
+			inputs = append(inputs, input)
+		}
+	}
+	return inputs
+}
+
+func (jr *JobRun) taskRunByTaskID(taskID string) *TaskRun {
+	for i := range jr.TaskRuns {
+		if jr.TaskRuns[i].TaskSpec.TaskID == taskID {
+			return &jr.TaskRuns[i]
+		}
+	}
+	return nil
+}
+
+// ReadyTaskRunIndexes returns the positions of the TaskRuns in a task graph
+// that can be executed now: those that can start, are not waiting on a bridge
+// or sleep, and whose inputs and condition have all completed or been
+// skipped.
+func (jr *JobRun) ReadyTaskRunIndexes() []int {
+	ready := []int{}
+	for index, tr := range jr.TaskRuns {
+		if !tr.Status.CanStart() || tr.Status.PendingBridge() || tr.Status.PendingSleep() {
+			continue
+		}
+		dependencies := jr.TaskRunInputs(index)
+		if taskID, _ := tr.TaskSpec.ConditionTaskID(); taskID != "" {
+			dependencies = append(dependencies, jr.taskRunByTaskID(taskID))
+		}
+		dependenciesDone := true
+		for _, dependency := range dependencies {
+			if dependency == nil || !(dependency.Status.Completed() || dependency.Status.Skipped()) {
+				dependenciesDone = false
+				break
+			}
+		}
+		if dependenciesDone {
+			ready = append(ready, index)
+		}
+	}
+	return ready
+}
+
+// SkipsTaskRun returns true if the TaskRun at the given position is to be
+// skipped rather than executed, because one of its inputs was skipped or its
+// condition is not met.
+func (jr *JobRun) SkipsTaskRun(index int) bool {
+	for _, input := range jr.TaskRunInputs(index) {
+		if input.Status.Skipped() {
+			return true
+		}
+	}
+
+	taskID, negated := jr.TaskRuns[index].TaskSpec.ConditionTaskID()
+	if taskID == "" {
+		return false
+	}
+	condition := jr.taskRunByTaskID(taskID)
+	if condition == nil || !condition.Status.Completed() {
+		return true
+	}
+	result := condition.Result.Data.Get("result")
+	isTrue := result.Type == gjson.True || (result.Type == gjson.String && result.Str == "true")
+	return isTrue == negated
+}
+
+// SkipTaskRun marks the TaskRun at the given position as skipped, completing
+// the run when no TaskRuns are left to execute.
+func (jr *JobRun) SkipTaskRun(index int) {
+	jr.TaskRuns[index].Status = RunStatusSkipped
+	if !jr.TasksRemain() {
+		jr.setStatus(RunStatusCompleted)
+	}
+}
+
+// NextPendingTaskRun returns the first TaskRun in one of the given statuses,
+// falling back to the next unfinished TaskRun if there is none.
+func (jr *JobRun) NextPendingTaskRun(statuses ...RunStatus) *TaskRun {
+	for i, tr := range jr.TaskRuns {
+		for _, status := range statuses {
+			if tr.Status == status {
+				return &jr.TaskRuns[i]
+			}
+		}
+	}
+	return jr.NextTaskRun()
+}
+
+// PendingBridgeTaskRun returns the TaskRun a bridge response should resume.
+// When taskRunID is nil, the first TaskRun waiting on a bridge is used.
+func (jr *JobRun) PendingBridgeTaskRun(taskRunID *ID) *TaskRun {
+	if taskRunID == nil {
+		return jr.NextPendingTaskRun(RunStatusPendingBridge)
+	}
+	for i, tr := range jr.TaskRuns {
+		if tr.ID.String() == taskRunID.String() && tr.Status.CanStart() {
+			return &jr.TaskRuns[i]
+		}
+	}
+	return nil
+}
+
+// WakeSleepingTaskRuns sets the TaskRuns sleeping until now or earlier back
+// in progress, along with the run. It returns false if none were due.
+func (jr *JobRun) WakeSleepingTaskRuns(now time.Time) bool {
+	woken := false
+	for i := range jr.TaskRuns {
+		tr := &jr.TaskRuns[i]
+		if tr.Status.PendingSleep() && !tr.WakeAt.Time.After(now) {
+			tr.Status = RunStatusInProgress
+			woken = true
+		}
+	}
+	if woken {
+		jr.Status = RunStatusInProgress
+	}
+	return woken
+}
+
+// AwaitingBridge returns true if the run, or any TaskRun in its task graph,
+// is waiting on a response from a bridge.
+func (jr *JobRun) AwaitingBridge() bool {
+	if jr.Status.PendingBridge() {
+		return true
+	}
+	for _, tr := range jr.TaskRuns {
+		if tr.Status.PendingBridge() {
+			return true
+		}
+	}
+	return false
+}
+
+// ApplyGraphOutputs updates the TaskRuns at the given positions in a task
+// graph with their outputs, then updates the JobRun's Result and Status.
+func (jr *JobRun) ApplyGraphOutputs(indexes []int, outputs []RunOutput) {
+	var err error
+	for i, index := range indexes {
+		taskRun := &jr.TaskRuns[index]
+		taskRun.ApplyOutput(outputs[i])
+		if outputs[i].HasError() && err == nil {
+			err = outputs[i].Error()
+		} else if outputs[i].Status().Completed() {
+			jr.Result.Data = outputs[i].Data()
+		}
+	}
+
+	if err != nil {
+		jr.SetError(err)
+		return
+	}
+
+	if !jr.TasksRemain() {
+		for i := len(jr.TaskRuns) - 1; i >= 0; i-- {
+			if jr.TaskRuns[i].Status.Completed() {
+				jr.Result.Data = jr.TaskRuns[i].Result.Data
+				break
+			}
+		}
+	}
+	jr.setStatus(RunStatusCompleted)
+}
+
+// SettleGraphStatus sets the status of a task graph run that has no TaskRuns
+// left that can be executed, so that it is resumed by whatever its remaining
+// TaskRuns are waiting on. Confirmations take precedence, since they resume
+// on every new head.
+func (jr *JobRun) SettleGraphStatus() {
+	if !jr.Status.Runnable() || !jr.TasksRemain() {
+		return
+	}
+	for _, status := range []RunStatus{
+		RunStatusPendingConfirmations,
+		RunStatusPendingConnection,
+		RunStatusPendingBridge,
+		RunStatusPendingSleep,
+	} {
+		for _, tr := range jr.TaskRuns {
+			if tr.Status == status {
+				jr.Status = status
+				return
+			}
+		}
+	}
+}
+
 // SetError sets this job run to failed and saves the error message
 func (jr *JobRun) SetError(err error) {
 	jr.Result.ErrorMessage = null.StringFrom(err.Error())
@@ -165,15 +378,20 @@ func (jr *JobRun) ErrorString() string {
 	return jr.Result.ErrorMessage.ValueOrZero()
 }
 
-// RunRequest stores the fields used to initiate the parent job run.
+// RunRequest stores the fields used to initiate the parent job run. A log
+// identified by its transaction, block and log index requests at most one run
+// of each job.
 type RunRequest struct {
-	ID        uint `gorm:"primary_key"`
-	RequestID *string
-	TxHash    *common.Hash
-	BlockHash *common.Hash
-	Requester *common.Address
-	CreatedAt time.Time
-	Payment   *assets.Link
+	ID          uint         `gorm:"primary_key"`
+	RequestID   *string      `gorm:"index"`
+	TxHash      *common.Hash `gorm:"index"`
+	BlockHash   *common.Hash
+	LogIndex    *uint
+	InitiatorID *uint
+	JobSpecID   *ID             `gorm:"type:varchar(36)"`
+	Requester   *common.Address `gorm:"index"`
+	CreatedAt   time.Time
+	Payment     *assets.Link
 }
 
 // NewRunRequest returns a new RunRequest instance.
@@ -193,6 +411,9 @@ type TaskRun struct {
 	TaskSpecID           uint          `json:"-" gorm:"index;not null REFERENCES task_specs(id)"`
 	MinimumConfirmations clnull.Uint32 `json:"minimumConfirmations"`
 	Confirmations        clnull.Uint32 `json:"confirmations"`
+	Attempts             uint32        `json:"attempts" gorm:"not null;default:0"`
+	LastError            null.String   `json:"lastError"`
+	WakeAt               null.Time     `json:"wakeAt"`
 	CreatedAt            time.Time     `json:"-" gorm:"index"`
 }
 
@@ -218,12 +439,22 @@ func (tr *TaskRun) ApplyBridgeRunResult(result BridgeRunResult) {
 
 // ApplyOutput updates the TaskRun's Result and Status
 func (tr *TaskRun) ApplyOutput(result RunOutput) {
+	if result.Attempts() > 0 {
+		tr.Attempts = result.Attempts()
+		tr.LastError = null.String{}
+		if err := result.LastError(); err != nil {
+			tr.LastError = null.StringFrom(err.Error())
+		}
+	}
 	if result.HasError() {
 		tr.SetError(result.Error())
 		return
 	}
 	tr.Result.Data = result.Data()
 	tr.Status = result.Status()
+	if tr.Status.PendingSleep() {
+		tr.WakeAt = null.TimeFrom(result.WakeAt())
+	}
 }
 
 // RunResult keeps track of the outcome of a TaskRun or JobRun. It stores the
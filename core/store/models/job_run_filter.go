@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// JobRunFilter selects the JobRuns matching all of its non-zero fields.
+type JobRunFilter struct {
+	JobSpecID *ID
+	// Statuses selects the runs in any of the statuses.
+	Statuses      []RunStatus
+	InitiatorType string
+	// The time ranges include their start, and exclude their end.
+	CreatedAfter   time.Time
+	CreatedBefore  time.Time
+	FinishedAfter  time.Time
+	FinishedBefore time.Time
+	// Requester, RequestID and TxHash match the RunRequest of the run, set
+	// by the Ethereum log that initiated it.
+	Requester *common.Address
+	RequestID string
+	TxHash    *common.Hash
+	// Error selects the runs whose error message contains it.
+	Error string
+}
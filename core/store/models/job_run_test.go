@@ -206,3 +206,178 @@ func TestJobRun_ApplyOutput_ErrorSetsFinishedAt(t *testing.T) {
 	jobRun.ApplyOutput(result)
 	assert.True(t, jobRun.FinishedAt.Valid)
 }
+
+func TestTaskRun_ApplyOutput_RecordsAttempts(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.CreateJob(&job))
+	jobRun := job.NewRun(job.Initiators[0])
+	require.NoError(t, store.CreateJobRun(&jobRun))
+
+	taskRun := &jobRun.TaskRuns[0]
+	taskRun.ApplyOutput(models.NewRunOutputError(errors.New("still down")).WithAttempts(3, errors.New("still down")))
+	assert.Equal(t, models.RunStatusErrored, taskRun.Status)
+	require.NoError(t, store.SaveJobRun(&jobRun))
+
+	jobRun, err := store.FindJobRun(jobRun.ID)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(3), jobRun.TaskRuns[0].Attempts)
+	assert.Equal(t, null.StringFrom("still down"), jobRun.TaskRuns[0].LastError)
+
+	taskRun = &jobRun.TaskRuns[0]
+	taskRun.ApplyOutput(models.NewRunOutputComplete(models.JSON{}).WithAttempts(1, nil))
+	assert.Equal(t, uint32(1), taskRun.Attempts)
+	assert.False(t, taskRun.LastError.Valid)
+}
+
+func newTaskGraphRun(t *testing.T) models.JobRun {
+	job := models.NewJob()
+	job.Tasks = []models.TaskSpec{
+		{Type: models.MustNewTaskType("noop"), TaskID: "a"},
+		{Type: models.MustNewTaskType("noop"), TaskID: "b"},
+		{Type: models.MustNewTaskType("noop"), TaskID: "join", Inputs: models.TaskInputs{"a", "b"}},
+	}
+	require.True(t, job.HasTaskGraph())
+	return job.NewRun(models.Initiator{Type: models.InitiatorWeb})
+}
+
+func TestJobRun_ReadyTaskRunIndexes(t *testing.T) {
+	t.Parallel()
+
+	run := newTaskGraphRun(t)
+	assert.Equal(t, []int{0, 1}, run.ReadyTaskRunIndexes())
+
+	run.TaskRuns[0].Status = models.RunStatusCompleted
+	run.TaskRuns[1].Status = models.RunStatusPendingBridge
+	assert.Equal(t, []int{}, run.ReadyTaskRunIndexes())
+
+	run.TaskRuns[1].Status = models.RunStatusCompleted
+	assert.Equal(t, []int{2}, run.ReadyTaskRunIndexes())
+}
+
+func TestJobRun_SkipsTaskRun(t *testing.T) {
+	t.Parallel()
+
+	job := models.NewJob()
+	job.Tasks = []models.TaskSpec{
+		{Type: models.MustNewTaskType("compare"), TaskID: "moved"},
+		{Type: models.MustNewTaskType("noop"), TaskID: "update", Condition: "moved"},
+		{Type: models.MustNewTaskType("noop"), Condition: "!moved"},
+		{Type: models.MustNewTaskType("noop")},
+	}
+	run := job.NewRun(models.Initiator{Type: models.InitiatorWeb})
+	run.Status = models.RunStatusInProgress
+
+	run.TaskRuns[0].ApplyOutput(models.NewRunOutputCompleteWithResult(false))
+	assert.True(t, run.SkipsTaskRun(1))
+	assert.False(t, run.SkipsTaskRun(2))
+
+	run.TaskRuns[0].ApplyOutput(models.NewRunOutputCompleteWithResult("true"))
+	assert.False(t, run.SkipsTaskRun(1))
+	assert.True(t, run.SkipsTaskRun(2))
+
+	run.SkipTaskRun(2)
+	assert.True(t, run.SkipsTaskRun(3), "tasks after a skipped task are skipped")
+	assert.Equal(t, models.RunStatusInProgress, run.Status)
+
+	run.TaskRuns[1].Status = models.RunStatusCompleted
+	run.SkipTaskRun(3)
+	assert.Equal(t, models.RunStatusCompleted, run.Status)
+	assert.True(t, run.FinishedAt.Valid)
+}
+
+func TestJobRun_TaskRunInputs(t *testing.T) {
+	t.Parallel()
+
+	run := newTaskGraphRun(t)
+	assert.Len(t, run.TaskRunInputs(0), 0)
+	inputs := run.TaskRunInputs(2)
+	require.Len(t, inputs, 2)
+	assert.Equal(t, "a", inputs[0].TaskSpec.TaskID)
+	assert.Equal(t, "b", inputs[1].TaskSpec.TaskID)
+
+	job := cltest.NewJobWithWebInitiator()
+	job.Tasks = append(job.Tasks, job.Tasks[0])
+	linear := job.NewRun(job.Initiators[0])
+	require.False(t, linear.HasTaskGraph())
+	require.Len(t, linear.TaskRunInputs(1), 1)
+	assert.Equal(t, linear.TaskRuns[0].ID, linear.TaskRunInputs(1)[0].ID)
+}
+
+func TestJobRun_ApplyGraphOutputs(t *testing.T) {
+	t.Parallel()
+
+	run := newTaskGraphRun(t)
+	run.Status = models.RunStatusInProgress
+
+	run.ApplyGraphOutputs([]int{0, 1}, []models.RunOutput{
+		models.NewRunOutputCompleteWithResult("1"),
+		models.NewRunOutputPendingBridge(),
+	})
+	assert.Equal(t, models.RunStatusInProgress, run.Status)
+	run.SettleGraphStatus()
+	assert.Equal(t, models.RunStatusPendingBridge, run.Status)
+	assert.True(t, run.AwaitingBridge())
+	assert.Equal(t, run.TaskRuns[1].ID, run.PendingBridgeTaskRun(nil).ID)
+	assert.Equal(t, run.TaskRuns[1].ID, run.PendingBridgeTaskRun(run.TaskRuns[1].ID).ID)
+
+	run.TaskRuns[1].Status = models.RunStatusCompleted
+	run.Status = models.RunStatusInProgress
+	run.ApplyGraphOutputs([]int{2}, []models.RunOutput{
+		models.NewRunOutputCompleteWithResult("2"),
+	})
+	assert.Equal(t, models.RunStatusCompleted, run.Status)
+	assert.Equal(t, "2", run.Result.Data.Get("result").String())
+}
+
+func TestJobRun_ApplyGraphOutputs_Error(t *testing.T) {
+	t.Parallel()
+
+	run := newTaskGraphRun(t)
+	run.ApplyGraphOutputs([]int{0, 1}, []models.RunOutput{
+		models.NewRunOutputCompleteWithResult("1"),
+		models.NewRunOutputError(errors.New("bad upstream")),
+	})
+	assert.Equal(t, models.RunStatusErrored, run.Status)
+	assert.Equal(t, "bad upstream", run.ErrorString())
+}
+
+func TestJobRun_SettleGraphStatus_PrefersConfirmations(t *testing.T) {
+	t.Parallel()
+
+	run := newTaskGraphRun(t)
+	run.Status = models.RunStatusInProgress
+	run.TaskRuns[0].Status = models.RunStatusPendingBridge
+	run.TaskRuns[1].Status = models.RunStatusPendingConfirmations
+
+	run.SettleGraphStatus()
+	assert.Equal(t, models.RunStatusPendingConfirmations, run.Status)
+	assert.True(t, run.AwaitingBridge())
+}
+
+func TestJobRun_TaskGraphPersistence(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{
+		{Type: models.MustNewTaskType("noop"), TaskID: "a"},
+		{Type: models.MustNewTaskType("noop"), TaskID: "join", Inputs: models.TaskInputs{"a"}, Condition: "!a"},
+	}
+	require.NoError(t, store.CreateJob(&job))
+	run := job.NewRun(job.Initiators[0])
+	require.NoError(t, store.CreateJobRun(&run))
+
+	run, err := store.FindJobRun(run.ID)
+	require.NoError(t, err)
+	require.True(t, run.HasTaskGraph())
+	assert.Equal(t, "a", run.TaskRuns[0].TaskSpec.TaskID)
+	assert.Len(t, run.TaskRuns[0].TaskSpec.Inputs, 0)
+	assert.Equal(t, models.TaskInputs{"a"}, run.TaskRuns[1].TaskSpec.Inputs)
+	assert.Equal(t, "!a", run.TaskRuns[1].TaskSpec.Condition)
+}
@@ -27,6 +27,13 @@ type JobSpecRequest struct {
 	MinPayment assets.Link        `json:"minPayment"`
 }
 
+// JobSimulationRequest represents a schema for a job spec to be simulated,
+// with the input to start its run with.
+type JobSimulationRequest struct {
+	JobSpecRequest
+	Input JSON `json:"input"`
+}
+
 // InitiatorRequest represents a schema for incoming initiator requests as used by the API.
 type InitiatorRequest struct {
 	Type            string `json:"type"`
@@ -55,18 +62,39 @@ func (i *InitiatorRequest) UnmarshalJSON(buf []byte) error {
 	return json.Unmarshal(temp.Params, &temp.Alias.InitiatorParams)
 }
 
+// MarshalJSON implements the json.Marshaler interface, keeping the params of
+// external initiators as they were given.
+func (i InitiatorRequest) MarshalJSON() ([]byte, error) {
+	type Alias InitiatorRequest
+	if i.Type != InitiatorExternal || i.Params == "" {
+		return json.Marshal(Alias(i))
+	}
+	return json.Marshal(struct {
+		Type   string          `json:"type"`
+		Name   string          `json:"name,omitempty"`
+		Params json.RawMessage `json:"params"`
+	}{i.Type, i.Name, json.RawMessage(i.Params)})
+}
+
 // TaskSpecRequest represents a schema for incoming TaskSpec requests as used by the API.
 type TaskSpecRequest struct {
 	Type          TaskType      `json:"type"`
+	TaskID        string        `json:"taskId,omitempty"`
+	Inputs        TaskInputs    `json:"inputs,omitempty"`
+	Condition     string        `json:"condition,omitempty"`
 	Confirmations clnull.Uint32 `json:"confirmations"`
+	Timeout       Duration      `json:"timeout,omitempty"`
 	Params        JSON          `json:"params"`
 }
 
 // JobSpec is the definition for all the work to be carried out by the node
 // for a given contract. It contains the Initiators, Tasks (which are the
 // individual steps to be carried out), StartAt, EndAt, and CreatedAt fields.
+// Updating a job creates a new Version of its Initiators and Tasks under the
+// same ID.
 type JobSpec struct {
 	ID         *ID         `json:"id,omitempty" gorm:"primary_key;not null"`
+	Version    uint32      `json:"version" gorm:"not null;default:1"`
 	CreatedAt  time.Time   `json:"createdAt" gorm:"index"`
 	Initiators []Initiator `json:"initiators"`
 	MinPayment assets.Link `json:"minPayment" gorm:"type:varchar(255)"`
@@ -96,6 +124,7 @@ func (j *JobSpec) SetID(value string) error {
 func NewJob() JobSpec {
 	return JobSpec{
 		ID:         NewID(),
+		Version:    1,
 		CreatedAt:  time.Now(),
 		MinPayment: *assets.NewLink(0),
 	}
@@ -111,10 +140,15 @@ func NewJobFromRequest(jsr JobSpecRequest) JobSpec {
 	}
 	for _, task := range jsr.Tasks {
 		jobSpec.Tasks = append(jobSpec.Tasks, TaskSpec{
-			JobSpecID:     jobSpec.ID,
-			Type:          task.Type,
-			Confirmations: task.Confirmations,
-			Params:        task.Params,
+			JobSpecID:      jobSpec.ID,
+			JobSpecVersion: jobSpec.Version,
+			Type:           task.Type,
+			TaskID:         task.TaskID,
+			Inputs:         task.Inputs,
+			Condition:      task.Condition,
+			Confirmations:  task.Confirmations,
+			Timeout:        task.Timeout,
+			Params:         task.Params,
 		})
 	}
 
@@ -129,6 +163,17 @@ func (j JobSpec) Archived() bool {
 	return j.DeletedAt.Valid
 }
 
+// HasTaskGraph returns true if any of the job's tasks declare inputs, in
+// which case tasks run as a graph instead of one after another.
+func (j JobSpec) HasTaskGraph() bool {
+	for _, task := range j.Tasks {
+		if len(task.Inputs) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // NewRun initializes the job by creating the IDs for the job
 // and all associated tasks, and setting the CreatedAt field.
 func (j JobSpec) NewRun(i Initiator) JobRun {
@@ -146,15 +191,16 @@ func (j JobSpec) NewRun(i Initiator) JobRun {
 	runRequest := NewRunRequest()
 	now := time.Now()
 	return JobRun{
-		ID:          jrid,
-		JobSpecID:   j.ID,
-		CreatedAt:   now,
-		UpdatedAt:   now,
-		TaskRuns:    taskRuns,
-		RunRequest:  *runRequest,
-		Initiator:   i,
-		InitiatorID: i.ID,
-		Status:      RunStatusUnstarted,
+		ID:             jrid,
+		JobSpecID:      j.ID,
+		JobSpecVersion: j.Version,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+		TaskRuns:       taskRuns,
+		RunRequest:     *runRequest,
+		Initiator:      i,
+		InitiatorID:    i.ID,
+		Status:         RunStatusUnstarted,
 	}
 }
 
@@ -240,6 +286,8 @@ const (
 type Initiator struct {
 	ID        uint `json:"id" gorm:"primary_key;auto_increment"`
 	JobSpecID *ID  `json:"jobSpecId" gorm:"index;type:varchar(36) REFERENCES job_specs(id)"`
+	// JobSpecVersion is the version of the job this Initiator belongs to.
+	JobSpecVersion uint32 `json:"-" gorm:"not null;default:1"`
 	// Type is one of the Initiator* string constants defined just above.
 	Type            string    `json:"type" gorm:"index;not null"`
 	CreatedAt       time.Time `gorm:"index"`
@@ -293,7 +341,8 @@ func NewInitiatorFromRequest(
 	jobSpec JobSpec,
 ) Initiator {
 	ret := Initiator{
-		JobSpecID: jobSpec.ID,
+		JobSpecID:      jobSpec.ID,
+		JobSpecVersion: jobSpec.Version,
 		// Type must be downcast to comply with Initiator
 		// deserialization logic. Ideally, Initiator.Type should be its
 		// own type (InitiatorType) that handles deserialization
@@ -314,12 +363,90 @@ func (i Initiator) IsLogInitiated() bool {
 // TaskSpec is the definition of work to be carried out. The
 // Type will be an adapter, and the Params will contain any
 // additional information that adapter would need to operate.
+//
+// A TaskSpec may be given a TaskID, unique within its job, which other tasks
+// list in their Inputs to depend on it. Tasks without Inputs in a job whose
+// tasks form a graph start as soon as the run does.
+//
+// A TaskSpec with a Condition only runs when the result of the task whose
+// TaskID it names is true, or false when the name is prefixed with "!".
+// Otherwise it is skipped, along with the tasks that take its result as
+// input, which in a job that isn't a graph are all the tasks after it.
+//
+// A non-zero Timeout bounds how long the task may run, overriding the node's
+// DefaultTaskTimeout.
 type TaskSpec struct {
 	gorm.Model
-	JobSpecID     *ID           `json:"-"`
-	Type          TaskType      `json:"type" gorm:"index;not null"`
-	Confirmations clnull.Uint32 `json:"confirmations"`
-	Params        JSON          `json:"params" gorm:"type:text"`
+	JobSpecID      *ID           `json:"-"`
+	JobSpecVersion uint32        `json:"-" gorm:"not null;default:1"`
+	Type           TaskType      `json:"type" gorm:"index;not null"`
+	TaskID         string        `json:"taskId,omitempty"`
+	Inputs         TaskInputs    `json:"inputs,omitempty" gorm:"type:text"`
+	Condition      string        `json:"condition,omitempty" gorm:"not null;default:''"`
+	Confirmations  clnull.Uint32 `json:"confirmations"`
+	Timeout        Duration      `json:"timeout,omitempty" gorm:"not null;default:0"`
+	Params         JSON          `json:"params" gorm:"type:text"`
+}
+
+// ConditionTaskID returns the TaskID named by the task's Condition, and
+// whether the task runs when that task's result is false rather than true.
+func (t TaskSpec) ConditionTaskID() (taskID string, negated bool) {
+	if strings.HasPrefix(t.Condition, "!") {
+		return t.Condition[1:], true
+	}
+	return t.Condition, false
+}
+
+// Dependencies returns the TaskIDs of the tasks that must finish before this
+// task can run: its Inputs and the task named by its Condition.
+func (t TaskSpec) Dependencies() []string {
+	dependencies := append([]string{}, t.Inputs...)
+	if taskID, _ := t.ConditionTaskID(); taskID != "" {
+		dependencies = append(dependencies, taskID)
+	}
+	return dependencies
+}
+
+// TaskInputs is the list of upstream TaskIDs a TaskSpec depends on.
+type TaskInputs []string
+
+// Value returns this instance serialized for database storage.
+func (ti TaskInputs) Value() (driver.Value, error) {
+	if len(ti) == 0 {
+		return "", nil
+	}
+	j, err := json.Marshal([]string(ti))
+	if err != nil {
+		return nil, err
+	}
+	return string(j), nil
+}
+
+// Scan reads the database value and returns an instance.
+func (ti *TaskInputs) Scan(value interface{}) error {
+	var temp string
+	switch v := value.(type) {
+	case nil:
+		*ti = nil
+		return nil
+	case string:
+		temp = v
+	case []byte:
+		temp = string(v)
+	default:
+		return fmt.Errorf("Unable to convert %v of %T to TaskInputs", value, value)
+	}
+
+	if len(temp) == 0 {
+		*ti = nil
+		return nil
+	}
+	var inputs []string
+	if err := json.Unmarshal([]byte(temp), &inputs); err != nil {
+		return errors.Wrapf(err, "Unable to convert %v of %T to TaskInputs", value, value)
+	}
+	*ti = inputs
+	return nil
 }
 
 // TaskType defines what Adapter a TaskSpec will use.
@@ -33,8 +33,9 @@ func TestNewInitiatorFromRequest(t *testing.T) {
 			},
 			jobSpec: job,
 			want: models.Initiator{
-				Type:      models.InitiatorExternal,
-				JobSpecID: job.ID,
+				Type:           models.InitiatorExternal,
+				JobSpecID:      job.ID,
+				JobSpecVersion: job.Version,
 				InitiatorParams: models.InitiatorParams{
 					Name: "somecoin",
 				},
@@ -47,8 +48,9 @@ func TestNewInitiatorFromRequest(t *testing.T) {
 			},
 			jobSpec: job,
 			want: models.Initiator{
-				Type:      models.InitiatorWeb,
-				JobSpecID: job.ID,
+				Type:           models.InitiatorWeb,
+				JobSpecID:      job.ID,
+				JobSpecVersion: job.Version,
 			},
 		},
 	}
@@ -175,6 +177,26 @@ func TestJobSpec_Save(t *testing.T) {
 	assert.Equal(t, initr.Schedule, j2.Initiators[0].Schedule)
 }
 
+func TestJobSpec_Save_TaskTimeout(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	var jsr models.JobSpecRequest
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"initiators": [{"type": "web"}],
+		"tasks": [{"type": "httpget", "timeout": "45s"}, {"type": "noop"}]
+	}`), &jsr))
+	j1 := models.NewJobFromRequest(jsr)
+	require.NoError(t, store.CreateJob(&j1))
+
+	j2, err := store.FindJob(j1.ID)
+	require.NoError(t, err)
+	require.Len(t, j2.Tasks, 2)
+	assert.Equal(t, 45*time.Second, j2.Tasks[0].Timeout.Duration())
+	assert.Equal(t, time.Duration(0), j2.Tasks[1].Timeout.Duration())
+}
+
 func TestJobSpec_NewRun(t *testing.T) {
 	t.Parallel()
 	store, cleanup := cltest.NewStore(t)
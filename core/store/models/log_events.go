@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"time"
 
 	"chainlink/core/assets"
 	"chainlink/core/eth"
@@ -213,9 +214,15 @@ func (le InitiatorLogEvent) BlockNumber() *big.Int {
 func (le InitiatorLogEvent) RunRequest() (RunRequest, error) {
 	txHash := common.BytesToHash(le.Log.TxHash.Bytes())
 	blockHash := common.BytesToHash(le.Log.BlockHash.Bytes())
+	logIndex := le.Log.Index
+	initiatorID := le.Initiator.ID
+	jobSpecID := le.JobSpecID
 	return RunRequest{
-		BlockHash: &blockHash,
-		TxHash:    &txHash,
+		BlockHash:   &blockHash,
+		TxHash:      &txHash,
+		LogIndex:    &logIndex,
+		InitiatorID: &initiatorID,
+		JobSpecID:   &jobSpecID,
 	}, nil
 }
 
@@ -336,14 +343,20 @@ func (le RunLogEvent) RunRequest() (RunRequest, error) {
 
 	txHash := common.BytesToHash(le.Log.TxHash.Bytes())
 	blockHash := common.BytesToHash(le.Log.BlockHash.Bytes())
+	logIndex := le.Log.Index
+	initiatorID := le.Initiator.ID
+	jobSpecID := le.JobSpecID
 	str := parser.parseRequestID(le.Log)
 	requester := le.Requester()
 	return RunRequest{
-		RequestID: &str,
-		TxHash:    &txHash,
-		BlockHash: &blockHash,
-		Requester: &requester,
-		Payment:   payment,
+		RequestID:   &str,
+		TxHash:      &txHash,
+		BlockHash:   &blockHash,
+		LogIndex:    &logIndex,
+		InitiatorID: &initiatorID,
+		JobSpecID:   &jobSpecID,
+		Requester:   &requester,
+		Payment:     payment,
 	}, nil
 }
 
@@ -488,3 +501,61 @@ func IDToTopic(id *ID) common.Hash {
 func IDToHexTopic(id *ID) common.Hash {
 	return common.BytesToHash([]byte(id.String()))
 }
+
+// LogCursor records the last log processed for an initiator, so that its
+// subscription resumes from there after a restart or reconnect.
+type LogCursor struct {
+	InitiatorID uint  `gorm:"primary_key;auto_increment:false"`
+	BlockNumber int64 `gorm:"not null"`
+	// LogIndex is -1 when no log of the block has been processed yet.
+	LogIndex  int64 `gorm:"not null"`
+	UpdatedAt time.Time
+}
+
+// NewLogCursor returns a cursor for the initiator that has processed no log
+// from the block on, or no log at all if the block is nil.
+func NewLogCursor(initiatorID uint, blockNumber *big.Int) *LogCursor {
+	cursor := &LogCursor{InitiatorID: initiatorID, LogIndex: -1}
+	if blockNumber != nil {
+		cursor.BlockNumber = blockNumber.Int64()
+	}
+	return cursor
+}
+
+// Processed returns true if the log is at or before the cursor. A nil cursor
+// has processed nothing.
+func (c *LogCursor) Processed(log eth.Log) bool {
+	if c == nil {
+		return false
+	}
+	blockNumber := int64(log.BlockNumber)
+	return blockNumber < c.BlockNumber ||
+		(blockNumber == c.BlockNumber && int64(log.Index) <= c.LogIndex)
+}
+
+// Advance moves the cursor to the log, if the log comes after it.
+func (c *LogCursor) Advance(log eth.Log) bool {
+	if c.Processed(log) {
+		return false
+	}
+	c.BlockNumber = int64(log.BlockNumber)
+	c.LogIndex = int64(log.Index)
+	return true
+}
+
+// Rewind moves the cursor back to before the block of a log that was removed
+// from the chain, if the cursor is past it.
+func (c *LogCursor) Rewind(log eth.Log) bool {
+	if !c.Processed(log) {
+		return false
+	}
+	c.BlockNumber = int64(log.BlockNumber)
+	c.LogIndex = -1
+	return true
+}
+
+// FromBlock returns the block a subscription resuming from the cursor starts
+// at, which may still hold unprocessed logs.
+func (c *LogCursor) FromBlock() *big.Int {
+	return big.NewInt(c.BlockNumber)
+}
@@ -477,7 +477,8 @@ func TestRunLogEvent_RunRequest(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			rle := models.RunLogEvent{models.InitiatorLogEvent{Log: test.log}}
+			initr := models.Initiator{ID: 7}
+			rle := models.RunLogEvent{models.InitiatorLogEvent{Log: test.log, Initiator: initr}}
 			rr, err := rle.RunRequest()
 			require.NoError(t, err)
 
@@ -485,10 +486,39 @@ func TestRunLogEvent_RunRequest(t *testing.T) {
 			assert.Equal(t, test.wantTxHash, rr.TxHash.Hex())
 			assert.Equal(t, test.wantBlockHash, rr.BlockHash.Hex())
 			assert.Equal(t, &test.wantRequester, rr.Requester)
+			assert.Equal(t, &test.log.Index, rr.LogIndex)
+			assert.Equal(t, &initr.ID, rr.InitiatorID)
+			assert.Equal(t, &rle.JobSpecID, rr.JobSpecID)
 		})
 	}
 }
 
+func TestLogCursor(t *testing.T) {
+	t.Parallel()
+
+	cursor := models.NewLogCursor(1, big.NewInt(10))
+	assert.Equal(t, big.NewInt(10), cursor.FromBlock())
+	assert.True(t, cursor.Processed(eth.Log{BlockNumber: 9, Index: 5}))
+	assert.False(t, cursor.Processed(eth.Log{BlockNumber: 10, Index: 0}))
+
+	assert.True(t, cursor.Advance(eth.Log{BlockNumber: 10, Index: 2}))
+	assert.True(t, cursor.Processed(eth.Log{BlockNumber: 10, Index: 2}))
+	assert.False(t, cursor.Processed(eth.Log{BlockNumber: 10, Index: 3}))
+	assert.False(t, cursor.Advance(eth.Log{BlockNumber: 10, Index: 1}))
+
+	assert.True(t, cursor.Advance(eth.Log{BlockNumber: 12, Index: 0}))
+	assert.Equal(t, big.NewInt(12), cursor.FromBlock())
+
+	assert.False(t, cursor.Rewind(eth.Log{BlockNumber: 13, Index: 0}))
+	assert.True(t, cursor.Rewind(eth.Log{BlockNumber: 11, Index: 4}))
+	assert.Equal(t, big.NewInt(11), cursor.FromBlock())
+	assert.False(t, cursor.Processed(eth.Log{BlockNumber: 11, Index: 0}))
+	assert.True(t, cursor.Processed(eth.Log{BlockNumber: 10, Index: 2}))
+
+	var none *models.LogCursor
+	assert.False(t, none.Processed(eth.Log{}))
+}
+
 func TestIDToTopic(t *testing.T) {
 	id, err := models.NewIDFromString("ffffffffffffffffffffffffffffffff")
 	require.NoError(t, err)
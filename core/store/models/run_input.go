@@ -8,9 +8,10 @@ import (
 
 // RunInput represents the input for performing a Task
 type RunInput struct {
-	jobRunID ID
-	data     JSON
-	status   RunStatus
+	jobRunID  ID
+	taskRunID *ID
+	data      JSON
+	status    RunStatus
 }
 
 // NewRunInput creates a new RunInput with arbitrary data
@@ -22,6 +23,14 @@ func NewRunInput(jobRunID *ID, data JSON, status RunStatus) *RunInput {
 	}
 }
 
+// NewRunInputForTaskRun creates a new RunInput with arbitrary data for the
+// given TaskRun of a JobRun
+func NewRunInputForTaskRun(jobRunID *ID, taskRunID *ID, data JSON, status RunStatus) *RunInput {
+	input := NewRunInput(jobRunID, data, status)
+	input.taskRunID = taskRunID
+	return input
+}
+
 // NewRunInputWithResult creates a new RunInput with a value in the "result" field
 func NewRunInputWithResult(jobRunID *ID, value interface{}, status RunStatus) *RunInput {
 	data, err := JSON{}.Add("result", value)
@@ -63,3 +72,9 @@ func (ri RunInput) Data() JSON {
 func (ri RunInput) JobRunID() *ID {
 	return &ri.jobRunID
 }
+
+// TaskRunID returns this RunInput's TaskRunID, if it was created for a
+// specific TaskRun
+func (ri RunInput) TaskRunID() *ID {
+	return ri.taskRunID
+}
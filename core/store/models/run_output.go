@@ -2,15 +2,19 @@ package models
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/tidwall/gjson"
 )
 
 // RunOutput represents the result of performing a Task
 type RunOutput struct {
-	data   JSON
-	status RunStatus
-	err    error
+	data     JSON
+	status   RunStatus
+	err      error
+	attempts uint32
+	lastErr  error
+	wakeAt   time.Time
 }
 
 // NewRunOutputError returns a new RunOutput with an error
@@ -75,6 +79,20 @@ func NewRunOutputPendingBridge() RunOutput {
 	return RunOutput{status: RunStatusPendingBridge}
 }
 
+// WithAttempts returns a copy of the RunOutput that records how many
+// attempts the task made and the error from the last failed attempt, if any.
+func (ro RunOutput) WithAttempts(attempts uint32, lastErr error) RunOutput {
+	ro.attempts = attempts
+	ro.lastErr = lastErr
+	return ro
+}
+
+// NewRunOutputPendingSleep returns a new RunOutput that indicates the task is
+// sleeping until the given time
+func NewRunOutputPendingSleep(wakeAt time.Time) RunOutput {
+	return RunOutput{status: RunStatusPendingSleep, wakeAt: wakeAt}
+}
+
 // HasError returns true if the status is errored or the error message is set
 func (ro RunOutput) HasError() bool {
 	return ro.status == RunStatusErrored
@@ -104,3 +122,19 @@ func (ro RunOutput) Data() JSON {
 func (ro RunOutput) Status() RunStatus {
 	return ro.status
 }
+
+// Attempts returns the number of attempts the task made, or 0 if the adapter
+// does not retry.
+func (ro RunOutput) Attempts() uint32 {
+	return ro.attempts
+}
+
+// WakeAt returns the time a sleeping task should be resumed
+func (ro RunOutput) WakeAt() time.Time {
+	return ro.wakeAt
+}
+
+// LastError returns the error from the task's last failed attempt
+func (ro RunOutput) LastError() error {
+	return ro.lastErr
+}
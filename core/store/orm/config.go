@@ -106,6 +106,12 @@ func (c Config) BridgeResponseURL() *url.URL {
 	return c.getWithFallback("BridgeResponseURL", parseURL).(*url.URL)
 }
 
+// BulkDeleteBatchSize is the number of runs deleted in each transaction when
+// runs are deleted in bulk.
+func (c Config) BulkDeleteBatchSize() uint {
+	return c.viper.GetUint(EnvVarName("BulkDeleteBatchSize"))
+}
+
 // ChainID represents the chain ID to use for transactions.
 func (c Config) ChainID() *big.Int {
 	return c.getWithFallback("ChainID", parseBigInt).(*big.Int)
@@ -133,6 +139,18 @@ func (c Config) DefaultHTTPLimit() int64 {
 	return c.viper.GetInt64(EnvVarName("DefaultHTTPLimit"))
 }
 
+// DefaultHTTPTimeout is how long an outbound HTTP request, such as to a
+// bridge, may take unless overridden. Zero means no limit.
+func (c Config) DefaultHTTPTimeout() time.Duration {
+	return c.viper.GetDuration(EnvVarName("DefaultHTTPTimeout"))
+}
+
+// DefaultTaskTimeout is how long a task may run before it is cancelled and
+// errored, unless its spec sets its own timeout. Zero means no limit.
+func (c Config) DefaultTaskTimeout() time.Duration {
+	return c.viper.GetDuration(EnvVarName("DefaultTaskTimeout"))
+}
+
 // Dev configures "development" mode for chainlink.
 func (c Config) Dev() bool {
 	return c.viper.GetBool(EnvVarName("Dev"))
@@ -160,6 +178,19 @@ func (c Config) MinimumServiceDuration() time.Duration {
 	return c.viper.GetDuration(EnvVarName("MinimumServiceDuration"))
 }
 
+// EthBroadcastTransactions enables sending transactions to every Ethereum
+// node, rather than only the one currently in use.
+func (c Config) EthBroadcastTransactions() bool {
+	return c.viper.GetBool(EnvVarName("EthBroadcastTransactions"))
+}
+
+// EthGasBumpPercent is the percentage by which the gas price of a
+// transaction is raised when bumping it, at least 10 since Ethereum nodes
+// reject smaller bumps. 0 raises it by EthGasBumpWei instead.
+func (c Config) EthGasBumpPercent() uint {
+	return c.viper.GetUint(EnvVarName("EthGasBumpPercent"))
+}
+
 // EthGasBumpThreshold represents the maximum amount a transaction's ETH amount
 // should be increased in order to facilitate a transaction.
 func (c Config) EthGasBumpThreshold() uint64 {
@@ -185,6 +216,26 @@ func (c Config) EthGasPriceDefault() *big.Int {
 	return c.getWithFallback("EthGasPriceDefault", parseBigInt).(*big.Int)
 }
 
+// EthGasEstimator names how the gas price of new transactions is chosen:
+// "fixed" uses EthGasPriceDefault, "percentile" a percentile of the gas
+// prices of recent blocks, and "node" the price suggested by the Ethereum
+// node.
+func (c Config) EthGasEstimator() string {
+	return c.viper.GetString(EnvVarName("EthGasEstimator"))
+}
+
+// EthGasPriceBlockHistory is how many recent blocks the percentile gas
+// estimator takes transaction prices from.
+func (c Config) EthGasPriceBlockHistory() uint {
+	return c.viper.GetUint(EnvVarName("EthGasPriceBlockHistory"))
+}
+
+// EthGasPricePercentile is the percentile of recent transaction prices the
+// percentile gas estimator suggests.
+func (c Config) EthGasPricePercentile() uint {
+	return c.viper.GetUint(EnvVarName("EthGasPricePercentile"))
+}
+
 // SetEthGasPriceDefault saves a runtime value for the default gas price for transactions
 func (c Config) SetEthGasPriceDefault(value *big.Int) error {
 	if c.runtimeStore == nil {
@@ -193,11 +244,47 @@ func (c Config) SetEthGasPriceDefault(value *big.Int) error {
 	return c.runtimeStore.SetConfigValue("EthGasPriceDefault", value)
 }
 
-// EthereumURL represents the URL of the Ethereum node to connect Chainlink to.
+// EthHealthCheckInterval is how often the heads of the Ethereum nodes are
+// compared, when secondary nodes are configured.
+func (c Config) EthHealthCheckInterval() time.Duration {
+	return c.viper.GetDuration(EnvVarName("EthHealthCheckInterval"))
+}
+
+// EthMaxGasPriceWei is the highest gas price any transaction is sent with,
+// including after bumping. 0 disables the ceiling.
+func (c Config) EthMaxGasPriceWei() *big.Int {
+	return c.getWithFallback("EthMaxGasPriceWei", parseBigInt).(*big.Int)
+}
+
+// EthMaxHeadLag is how many blocks an Ethereum node's head may fall behind
+// the other nodes before it is considered unhealthy. 0 disables the check.
+func (c Config) EthMaxHeadLag() uint64 {
+	return c.viper.GetUint64(EnvVarName("EthMaxHeadLag"))
+}
+
+// EthMinGasPriceWei is the lowest gas price any transaction is sent with.
+func (c Config) EthMinGasPriceWei() *big.Int {
+	return c.getWithFallback("EthMinGasPriceWei", parseBigInt).(*big.Int)
+}
+
+// EthPollInterval is how often Ethereum nodes connected over HTTP, which
+// cannot push notifications, are polled for new heads and logs.
+func (c Config) EthPollInterval() time.Duration {
+	return c.viper.GetDuration(EnvVarName("EthPollInterval"))
+}
+
+// EthereumURL represents the websocket or HTTP URL of the primary Ethereum
+// node to connect Chainlink to.
 func (c Config) EthereumURL() string {
 	return c.viper.GetString(EnvVarName("EthereumURL"))
 }
 
+// EthereumSecondaryURLs are the URLs of the Ethereum nodes to fail over to
+// when the primary node is unhealthy, given as a comma separated list.
+func (c Config) EthereumSecondaryURLs() string {
+	return c.viper.GetString(EnvVarName("EthereumSecondaryURLs"))
+}
+
 // JSONConsole enables the JSON console.
 func (c Config) JSONConsole() bool {
 	return c.viper.GetBool(EnvVarName("JSONConsole"))
@@ -208,6 +295,82 @@ func (c Config) LinkContractAddress() string {
 	return c.viper.GetString(EnvVarName("LinkContractAddress"))
 }
 
+// HTTPAllowedCIDRs is a comma separated list of the networks outbound HTTP
+// requests may connect to. When neither it nor HTTPAllowedHosts are set, any
+// address that isn't blocked is allowed.
+func (c Config) HTTPAllowedCIDRs() string {
+	return c.viper.GetString(EnvVarName("HTTPAllowedCIDRs"))
+}
+
+// HTTPAllowedHosts is a comma separated list of the hostnames outbound HTTP
+// requests may connect to, whatever address they resolve to. A leading "*."
+// matches any subdomain.
+func (c Config) HTTPAllowedHosts() string {
+	return c.viper.GetString(EnvVarName("HTTPAllowedHosts"))
+}
+
+// HTTPBlockedCIDRs is a comma separated list of the networks outbound HTTP
+// requests may never connect to, even when allowed otherwise.
+func (c Config) HTTPBlockedCIDRs() string {
+	return c.viper.GetString(EnvVarName("HTTPBlockedCIDRs"))
+}
+
+// HTTPBlockedHosts is a comma separated list of the hostnames outbound HTTP
+// requests may never connect to. A leading "*." matches any subdomain.
+func (c Config) HTTPBlockedHosts() string {
+	return c.viper.GetString(EnvVarName("HTTPBlockedHosts"))
+}
+
+// HTTPCABundlePath is the path to a PEM file of certificate authorities
+// trusted for outbound HTTPS requests, in addition to the system's.
+func (c Config) HTTPCABundlePath() string {
+	return c.viper.GetString(EnvVarName("HTTPCABundlePath"))
+}
+
+// HTTPClientCertPath is the path to the PEM certificate presented to servers
+// that require mutual TLS on outbound HTTPS requests.
+func (c Config) HTTPClientCertPath() string {
+	return c.viper.GetString(EnvVarName("HTTPClientCertPath"))
+}
+
+// HTTPClientKeyPath is the path to the PEM private key of HTTPClientCertPath.
+func (c Config) HTTPClientKeyPath() string {
+	return c.viper.GetString(EnvVarName("HTTPClientKeyPath"))
+}
+
+// HTTPIdleConnTimeout is how long an idle outbound HTTP connection is kept
+// open for reuse.
+func (c Config) HTTPIdleConnTimeout() time.Duration {
+	return c.viper.GetDuration(EnvVarName("HTTPIdleConnTimeout"))
+}
+
+// HTTPMaxIdleConns is the number of idle outbound HTTP connections kept open
+// for reuse across all hosts.
+func (c Config) HTTPMaxIdleConns() int {
+	return c.viper.GetInt(EnvVarName("HTTPMaxIdleConns"))
+}
+
+// HTTPMaxIdleConnsPerHost is the number of idle outbound HTTP connections
+// kept open for reuse with each host.
+func (c Config) HTTPMaxIdleConnsPerHost() int {
+	return c.viper.GetInt(EnvVarName("HTTPMaxIdleConnsPerHost"))
+}
+
+// HTTPProxyURL is the proxy outbound HTTP requests are sent through. When
+// unset, the HTTP_PROXY, HTTPS_PROXY and NO_PROXY variables are used.
+func (c Config) HTTPProxyURL() *url.URL {
+	rval := c.getWithFallback("HTTPProxyURL", parseURL)
+	switch t := rval.(type) {
+	case nil:
+		return nil
+	case *url.URL:
+		return t
+	default:
+		logger.Panicf("invariant: HTTPProxyURL returned as type %T", rval)
+		return nil
+	}
+}
+
 // ExplorerURL returns the websocket URL for this node to push stats to, or nil.
 func (c Config) ExplorerURL() *url.URL {
 	rval := c.getWithFallback("ExplorerURL", parseURL)
@@ -260,6 +423,13 @@ func (c Config) LogSQLMigrations() bool {
 	return c.viper.GetBool(EnvVarName("LogSQLMigrations"))
 }
 
+// MetricsEnabled serves Prometheus metrics at /metrics, without
+// authentication so that Prometheus can scrape them, and keeps the balance
+// gauges up to date on every new head.
+func (c Config) MetricsEnabled() bool {
+	return c.viper.GetBool(EnvVarName("MetricsEnabled"))
+}
+
 // MinIncomingConfirmations represents the minimum number of block
 // confirmations that need to be recorded since a job run started before a task
 // can proceed.
@@ -305,6 +475,12 @@ func (c Config) RootDir() string {
 	return c.getWithFallback("RootDir", parseHomeDir).(string)
 }
 
+// RunRetentionPeriod is how long completed runs are kept after they were last
+// updated, before they are deleted. Runs are kept forever when it is zero.
+func (c Config) RunRetentionPeriod() time.Duration {
+	return c.viper.GetDuration(EnvVarName("RunRetentionPeriod"))
+}
+
 // SecureCookies allows toggling of the secure cookies HTTP flag
 func (c Config) SecureCookies() bool {
 	return c.viper.GetBool(EnvVarName("SecureCookies"))
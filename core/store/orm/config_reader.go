@@ -16,20 +16,45 @@ import (
 type ConfigReader interface {
 	AllowOrigins() string
 	BridgeResponseURL() *url.URL
+	BulkDeleteBatchSize() uint
 	ChainID() *big.Int
 	ClientNodeURL() string
 	DatabaseTimeout() time.Duration
 	DatabaseURL() string
 	DefaultHTTPLimit() int64
+	DefaultHTTPTimeout() time.Duration
+	DefaultTaskTimeout() time.Duration
 	Dev() bool
 	FeatureExternalInitiators() bool
+	HTTPAllowedCIDRs() string
+	HTTPAllowedHosts() string
+	HTTPBlockedCIDRs() string
+	HTTPBlockedHosts() string
+	HTTPCABundlePath() string
+	HTTPClientCertPath() string
+	HTTPClientKeyPath() string
+	HTTPIdleConnTimeout() time.Duration
+	HTTPMaxIdleConns() int
+	HTTPMaxIdleConnsPerHost() int
+	HTTPProxyURL() *url.URL
 	MaximumServiceDuration() time.Duration
 	MinimumServiceDuration() time.Duration
+	EthBroadcastTransactions() bool
+	EthGasBumpPercent() uint
 	EthGasBumpThreshold() uint64
 	EthGasBumpWei() *big.Int
 	EthGasPriceDefault() *big.Int
 	SetEthGasPriceDefault(value *big.Int) error
+	EthGasEstimator() string
+	EthGasPriceBlockHistory() uint
+	EthGasPricePercentile() uint
+	EthHealthCheckInterval() time.Duration
+	EthMaxGasPriceWei() *big.Int
+	EthMaxHeadLag() uint64
+	EthMinGasPriceWei() *big.Int
+	EthPollInterval() time.Duration
 	EthereumURL() string
+	EthereumSecondaryURLs() string
 	JSONConsole() bool
 	LinkContractAddress() string
 	ExplorerURL() *url.URL
@@ -39,6 +64,7 @@ type ConfigReader interface {
 	LogLevel() LogLevel
 	LogToDisk() bool
 	LogSQLStatements() bool
+	MetricsEnabled() bool
 	MinIncomingConfirmations() uint32
 	MinOutgoingConfirmations() uint64
 	MinimumContractPayment() *assets.Link
@@ -46,6 +72,7 @@ type ConfigReader interface {
 	Port() uint16
 	ReaperExpiration() time.Duration
 	RootDir() string
+	RunRetentionPeriod() time.Duration
 	SecureCookies() bool
 	SessionTimeout() time.Duration
 	TLSCertPath() string
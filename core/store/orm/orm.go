@@ -32,6 +32,9 @@ import (
 var (
 	// ErrorNotFound is returned when finding a single value fails.
 	ErrorNotFound = gorm.ErrRecordNotFound
+	// ErrServiceAgreementJob is returned when updating a job that belongs to a
+	// service agreement, whose signature covers the job as it was agreed.
+	ErrServiceAgreementJob = errors.New("cannot update the job of a service agreement")
 )
 
 // DialectName is a compiler enforced type used that maps to gorm's dialect
@@ -202,10 +205,11 @@ func (orm *ORM) FindBridge(name models.TaskType) (models.BridgeType, error) {
 }
 
 // PendingBridgeType returns the bridge type of the current pending task,
-// or error if not pending bridge.
-func (orm *ORM) PendingBridgeType(jr models.JobRun) (models.BridgeType, error) {
+// or error if not pending bridge. If taskRunID is given, the bridge type of
+// that task is returned instead.
+func (orm *ORM) PendingBridgeType(jr models.JobRun, taskRunID *models.ID) (models.BridgeType, error) {
 	orm.MustEnsureAdvisoryLock()
-	nextTask := jr.NextTaskRun()
+	nextTask := jr.PendingBridgeTaskRun(taskRunID)
 	if nextTask == nil {
 		return models.BridgeType{}, errors.New("Cannot find the pending bridge type of a job run with no unfinished tasks")
 	}
@@ -228,13 +232,18 @@ func (orm *ORM) FindInitiator(ID uint) (models.Initiator, error) {
 		First(&initr, "id = ?", ID).Error
 }
 
+// preloadJobs loads the initiators and tasks of each job's current version.
 func (orm *ORM) preloadJobs() *gorm.DB {
 	return orm.db.
 		Preload("Initiators", func(db *gorm.DB) *gorm.DB {
-			return db.Unscoped().Order(`"id" asc`)
+			return db.Unscoped().
+				Where("job_spec_version = (SELECT version FROM job_specs WHERE job_specs.id = initiators.job_spec_id)").
+				Order(`"id" asc`)
 		}).
 		Preload("Tasks", func(db *gorm.DB) *gorm.DB {
-			return db.Unscoped().Order("id asc")
+			return db.Unscoped().
+				Where("job_spec_version = (SELECT version FROM job_specs WHERE job_specs.id = task_specs.job_spec_id)").
+				Order("id asc")
 		})
 }
 
@@ -458,6 +467,25 @@ func (orm *ORM) JobRunsCountFor(jobSpecID *models.ID) (int, error) {
 	return count, err
 }
 
+// JobRunStatusCount is the number of runs of a job in a given status.
+type JobRunStatusCount struct {
+	JobSpecID *models.ID
+	Status    models.RunStatus
+	Count     int
+}
+
+// JobRunStatusCounts returns the number of runs of each job in each status.
+func (orm *ORM) JobRunStatusCounts() ([]JobRunStatusCount, error) {
+	orm.MustEnsureAdvisoryLock()
+	var counts []JobRunStatusCount
+	err := orm.db.
+		Model(&models.JobRun{}).
+		Select("job_spec_id, status, count(*) as count").
+		Group("job_spec_id, status").
+		Scan(&counts).Error
+	return counts, err
+}
+
 // Sessions returns all sessions limited by the parameters.
 func (orm *ORM) Sessions(offset, limit int) ([]models.Session, error) {
 	orm.MustEnsureAdvisoryLock()
@@ -511,6 +539,89 @@ func (orm *ORM) createJob(tx *gorm.DB, job *models.JobSpec) error {
 	return tx.Create(job).Error
 }
 
+// UpdateJob saves the initiators and tasks of job as a new version of the
+// existing job with the same ID, and sets job.Version accordingly. The
+// previous version's initiators and tasks are soft deleted but kept, so runs
+// started before the update carry on with them.
+func (orm *ORM) UpdateJob(job *models.JobSpec) error {
+	orm.MustEnsureAdvisoryLock()
+	return orm.convenientTransaction(func(dbtx *gorm.DB) error {
+		var current models.JobSpec
+		if err := dbtx.First(&current, "id = ?", job.ID).Error; err != nil {
+			return err
+		}
+
+		var agreements int
+		err := dbtx.Model(&models.ServiceAgreement{}).Where("job_spec_id = ?", job.ID).Count(&agreements).Error
+		if err != nil {
+			return err
+		} else if agreements > 0 {
+			return ErrServiceAgreementJob
+		}
+
+		var previous []models.Initiator
+		if err := dbtx.Where("job_spec_id = ?", job.ID).Find(&previous).Error; err != nil {
+			return err
+		}
+
+		job.Version = current.Version + 1
+		job.CreatedAt = current.CreatedAt
+		err = multierr.Combine(
+			dbtx.Where("job_spec_id = ?", job.ID).Delete(&models.Initiator{}).Error,
+			dbtx.Where("job_spec_id = ?", job.ID).Delete(&models.TaskSpec{}).Error,
+			dbtx.Model(&models.JobSpec{}).Where("id = ?", job.ID).Updates(map[string]interface{}{
+				"version":     job.Version,
+				"min_payment": job.MinPayment,
+				"start_at":    job.StartAt,
+				"end_at":      job.EndAt,
+			}).Error,
+		)
+		if err != nil {
+			return err
+		}
+
+		for i := range job.Initiators {
+			initr := &job.Initiators[i]
+			initr.ID = 0
+			initr.JobSpecID = job.ID
+			initr.JobSpecVersion = job.Version
+			if err := dbtx.Create(initr).Error; err != nil {
+				return err
+			}
+			if previous, err = carryOverLogCursor(dbtx, previous, initr); err != nil {
+				return err
+			}
+		}
+		for i := range job.Tasks {
+			task := &job.Tasks[i]
+			task.ID = 0
+			task.JobSpecID = job.ID
+			task.JobSpecVersion = job.Version
+			if err := dbtx.Create(task).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// carryOverLogCursor moves the log cursor of the first previous initiator of
+// the same type and address to initr, so that its subscription resumes where
+// the previous version of the job left off. It returns the previous
+// initiators whose cursors were not taken.
+func carryOverLogCursor(dbtx *gorm.DB, previous []models.Initiator, initr *models.Initiator) ([]models.Initiator, error) {
+	for i, prev := range previous {
+		if prev.Type != initr.Type || prev.Address != initr.Address {
+			continue
+		}
+		err := dbtx.Table("log_cursors").
+			Where("initiator_id = ?", prev.ID).
+			Update("initiator_id", initr.ID).Error
+		return append(previous[:i:i], previous[i+1:]...), err
+	}
+	return previous, nil
+}
+
 // ArchiveJob soft deletes the job and its associated job runs.
 func (orm *ORM) ArchiveJob(ID *models.ID) error {
 	orm.MustEnsureAdvisoryLock()
@@ -543,6 +654,33 @@ func (orm *ORM) CreateServiceAgreement(sa *models.ServiceAgreement) error {
 	})
 }
 
+// CreateJobBundle saves the bridges, jobs and service agreements imported
+// from a JobBundle, either all of them or, on error, none.
+func (orm *ORM) CreateJobBundle(bridges []models.BridgeType, jobs []models.JobSpec, sas []models.ServiceAgreement) error {
+	orm.MustEnsureAdvisoryLock()
+	return orm.convenientTransaction(func(dbtx *gorm.DB) error {
+		for i := range bridges {
+			if err := dbtx.Create(&bridges[i]).Error; err != nil {
+				return errors.Wrapf(err, "creating bridge %s", bridges[i].Name)
+			}
+		}
+		for i := range jobs {
+			if err := orm.createJob(dbtx, &jobs[i]); err != nil {
+				return errors.Wrapf(err, "creating job %s", jobs[i].ID)
+			}
+		}
+		for i := range sas {
+			if err := orm.createJob(dbtx, &sas[i].JobSpec); err != nil {
+				return errors.Wrapf(err, "creating job for service agreement %s", sas[i].ID)
+			}
+			if err := dbtx.Create(&sas[i]).Error; err != nil {
+				return errors.Wrapf(err, "creating service agreement %s", sas[i].ID)
+			}
+		}
+		return nil
+	})
+}
+
 // UnscopedJobRunsWithStatus passes all JobRuns to a callback, one by one,
 // including those that were soft deleted.
 func (orm *ORM) UnscopedJobRunsWithStatus(cb func(*models.JobRun), statuses ...models.RunStatus) error {
@@ -570,6 +708,34 @@ func (orm *ORM) UnscopedJobRunsWithStatus(cb func(*models.JobRun), statuses ...m
 	return nil
 }
 
+// UnscopedJobRunsWithSleepingTasksDue calls cb with each unfinished job run
+// that has a TaskRun sleeping until the given time or earlier.
+func (orm *ORM) UnscopedJobRunsWithSleepingTasksDue(cb func(*models.JobRun), now time.Time) error {
+	orm.MustEnsureAdvisoryLock()
+	var runIDs []string
+	err := orm.db.Unscoped().
+		Table("job_runs").
+		Where("status NOT IN (?)", []models.RunStatus{models.RunStatusCompleted, models.RunStatusErrored, models.RunStatusCancelled}).
+		Where("id IN (SELECT job_run_id FROM task_runs WHERE status = ? AND wake_at <= ?)", models.RunStatusPendingSleep, now).
+		Order("created_at asc").
+		Pluck("ID", &runIDs).Error
+	if err != nil {
+		return fmt.Errorf("error finding job ids %v", err)
+	}
+
+	for _, id := range runIDs {
+		var run models.JobRun
+		err := orm.Unscoped().preloadJobRuns().First(&run, "id = ?", id).Error
+		if err != nil {
+			return fmt.Errorf("error finding job run %v", err)
+		}
+
+		cb(&run)
+	}
+
+	return nil
+}
+
 // AnyJobWithType returns true if there is at least one job associated with
 // the type name specified and false otherwise
 func (orm *ORM) AnyJobWithType(taskTypeName string) (bool, error) {
@@ -1009,6 +1175,71 @@ func (orm *ORM) JobRunsSortedFor(id *models.ID, order SortType, offset int, limi
 	return runs, count, err
 }
 
+// JobRunsFiltered returns the job runs matching the filter, ordered by when
+// they were created, along with the number of runs that match it.
+func (orm *ORM) JobRunsFiltered(filter models.JobRunFilter, order SortType, offset int, limit int) ([]models.JobRun, int, error) {
+	orm.MustEnsureAdvisoryLock()
+	var count int
+	err := filterJobRuns(orm.db.Model(&models.JobRun{}), filter).Count(&count).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var runs []models.JobRun
+	err = filterJobRuns(orm.preloadJobRuns(), filter).
+		Order(fmt.Sprintf("created_at %s", order.String())).
+		Limit(limit).
+		Offset(offset).
+		Find(&runs).Error
+	return runs, count, err
+}
+
+// filterJobRuns limits the query to the job runs matching the filter. The
+// run's initiator, request and result are matched with subqueries rather
+// than joins, so that the columns of those tables are not selected.
+func filterJobRuns(db *gorm.DB, filter models.JobRunFilter) *gorm.DB {
+	if filter.JobSpecID != nil {
+		db = db.Where("job_spec_id = ?", filter.JobSpecID)
+	}
+	if len(filter.Statuses) > 0 {
+		db = db.Where("status IN (?)", models.RunStatusCollection(filter.Statuses).ToStrings())
+	}
+	if filter.InitiatorType != "" {
+		db = db.Where("initiator_id IN (SELECT id FROM initiators WHERE type = ?)", filter.InitiatorType)
+	}
+	if !filter.CreatedAfter.IsZero() {
+		db = db.Where("created_at >= ?", filter.CreatedAfter)
+	}
+	if !filter.CreatedBefore.IsZero() {
+		db = db.Where("created_at < ?", filter.CreatedBefore)
+	}
+	if !filter.FinishedAfter.IsZero() {
+		db = db.Where("finished_at >= ?", filter.FinishedAfter)
+	}
+	if !filter.FinishedBefore.IsZero() {
+		db = db.Where("finished_at < ?", filter.FinishedBefore)
+	}
+	if filter.Requester != nil {
+		db = db.Where("run_request_id IN (SELECT id FROM run_requests WHERE requester = ?)", filter.Requester)
+	}
+	if filter.RequestID != "" {
+		db = db.Where("run_request_id IN (SELECT id FROM run_requests WHERE request_id = ?)", filter.RequestID)
+	}
+	if filter.TxHash != nil {
+		db = db.Where("run_request_id IN (SELECT id FROM run_requests WHERE tx_hash = ?)", filter.TxHash)
+	}
+	if filter.Error != "" {
+		db = db.Where(`result_id IN (SELECT id FROM run_results WHERE error_message LIKE ? ESCAPE '\')`, "%"+escapeLike(filter.Error)+"%")
+	}
+	return db
+}
+
+// escapeLike escapes the wildcards of a LIKE pattern, using \ as the escape
+// character.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
 // BridgeTypes returns bridge types ordered by name filtered limited by the
 // passed params.
 func (orm *ORM) BridgeTypes(offset int, limit int) ([]models.BridgeType, int, error) {
@@ -1023,6 +1254,32 @@ func (orm *ORM) BridgeTypes(offset int, limit int) ([]models.BridgeType, int, er
 	return bridges, count, err
 }
 
+// CreateAuditLogEntry appends the entry to the audit log.
+func (orm *ORM) CreateAuditLogEntry(entry *models.AuditLogEntry) error {
+	orm.MustEnsureAdvisoryLock()
+	return orm.db.Create(entry).Error
+}
+
+// AuditLogEntries returns a page of the audit log, newest first, along with
+// the number of entries. When action is set, only entries for that action are
+// returned.
+func (orm *ORM) AuditLogEntries(action models.AuditAction, offset int, limit int) ([]models.AuditLogEntry, int, error) {
+	orm.MustEnsureAdvisoryLock()
+	query := orm.db.Model(&models.AuditLogEntry{})
+	if action != "" {
+		query = query.Where("action = ?", action)
+	}
+
+	var count int
+	if err := query.Count(&count).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var entries []models.AuditLogEntry
+	err := query.Order("id desc").Offset(offset).Limit(limit).Find(&entries).Error
+	return entries, count, err
+}
+
 // SaveUser saves the user.
 func (orm *ORM) SaveUser(user *models.User) error {
 	orm.MustEnsureAdvisoryLock()
@@ -1053,6 +1310,8 @@ func (orm *ORM) UpdateBridgeType(bt *models.BridgeType, btr *models.BridgeTypeRe
 	bt.URL = btr.URL
 	bt.Confirmations = btr.Confirmations
 	bt.MinimumContractPayment = btr.MinimumContractPayment
+	bt.BridgeHTTPOptions = btr.BridgeHTTPOptions
+	bt.UpdateSigning(btr)
 	return orm.db.Save(bt).Error
 }
 
@@ -1090,6 +1349,91 @@ func (orm *ORM) LastHead() (*models.Head, error) {
 	return number, err
 }
 
+// LastHeads returns the most recently persisted head entries, newest first.
+func (orm *ORM) LastHeads(limit int) ([]models.Head, error) {
+	orm.MustEnsureAdvisoryLock()
+	var heads []models.Head
+	err := orm.db.Order("number desc").Limit(limit).Find(&heads).Error
+	return heads, err
+}
+
+// DeleteHeadsSince deletes the persisted heads at the block number or later.
+func (orm *ORM) DeleteHeadsSince(number int64) error {
+	orm.MustEnsureAdvisoryLock()
+	return orm.db.Where("number >= ?", number).Delete(models.Head{}).Error
+}
+
+// UnfinishedJobRunIDsInBlocks returns the IDs of the unfinished job runs
+// requested by a log in any of the blocks.
+func (orm *ORM) UnfinishedJobRunIDsInBlocks(blockHashes []common.Hash) ([]*models.ID, error) {
+	orm.MustEnsureAdvisoryLock()
+	var runIDs []*models.ID
+	err := orm.unfinishedJobRuns().
+		Where("run_request_id IN (SELECT id FROM run_requests WHERE block_hash IN (?))", blockHashes).
+		Order("created_at asc").
+		Pluck("id", &runIDs).Error
+	return runIDs, err
+}
+
+// UnfinishedJobRunIDsForLog returns the IDs of the unfinished job runs of the
+// job requested by a log in the transaction and block.
+func (orm *ORM) UnfinishedJobRunIDsForLog(jobSpecID *models.ID, txHash, blockHash common.Hash) ([]*models.ID, error) {
+	orm.MustEnsureAdvisoryLock()
+	var runIDs []*models.ID
+	err := orm.unfinishedJobRuns().
+		Where("job_spec_id = ?", jobSpecID).
+		Where("run_request_id IN (SELECT id FROM run_requests WHERE tx_hash = ? AND block_hash = ?)", txHash, blockHash).
+		Order("created_at asc").
+		Pluck("id", &runIDs).Error
+	return runIDs, err
+}
+
+// LogRunRequested returns true if a run of the job was already requested by
+// the log the run request is for, under any version of the job.
+func (orm *ORM) LogRunRequested(rr models.RunRequest) (bool, error) {
+	orm.MustEnsureAdvisoryLock()
+	if rr.TxHash == nil || rr.BlockHash == nil || rr.LogIndex == nil || rr.JobSpecID == nil {
+		return false, nil
+	}
+	var count int
+	err := orm.db.
+		Model(&models.RunRequest{}).
+		Where("job_spec_id = ? AND tx_hash = ? AND block_hash = ? AND log_index = ?",
+			rr.JobSpecID, rr.TxHash, rr.BlockHash, rr.LogIndex).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// FindLogCursor returns the log cursor of the initiator, or nil if none of its
+// logs have been processed.
+func (orm *ORM) FindLogCursor(initiatorID uint) (*models.LogCursor, error) {
+	orm.MustEnsureAdvisoryLock()
+	cursor := &models.LogCursor{}
+	err := orm.db.Where("initiator_id = ?", initiatorID).First(cursor).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return cursor, err
+}
+
+// SaveLogCursor creates or updates the log cursor of an initiator.
+func (orm *ORM) SaveLogCursor(cursor *models.LogCursor) error {
+	orm.MustEnsureAdvisoryLock()
+	return orm.db.Save(cursor).Error
+}
+
+func (orm *ORM) unfinishedJobRuns() *gorm.DB {
+	return orm.db.
+		Table("job_runs").
+		Where("deleted_at IS NULL").
+		Where("status NOT IN (?)", []models.RunStatus{
+			models.RunStatusCompleted,
+			models.RunStatusErrored,
+			models.RunStatusCancelled,
+			models.RunStatusSkipped,
+		})
+}
+
 // DeleteStaleSessions deletes all sessions before the passed time.
 func (orm *ORM) DeleteStaleSessions(before time.Time) error {
 	orm.MustEnsureAdvisoryLock()
@@ -1106,24 +1450,52 @@ func (orm *ORM) DeleteTransaction(ethtx *models.Tx) error {
 	})
 }
 
-// BulkDeleteRuns removes JobRuns and their related records: TaskRuns and
-// RunResults.
+// bulkDeleteRunsBatchSize is the number of JobRuns BulkDeleteRuns deletes in
+// each transaction.
+const bulkDeleteRunsBatchSize = 1000
+
+// BulkDeleteRuns removes all the JobRuns matching the query and their related
+// records, in batches.
+func (orm *ORM) BulkDeleteRuns(bulkQuery *models.BulkDeleteRunRequest) error {
+	for {
+		deleted, err := orm.BulkDeleteRunsBatch(bulkQuery, bulkDeleteRunsBatchSize)
+		if err != nil || deleted < bulkDeleteRunsBatchSize {
+			return err
+		}
+	}
+}
+
+// BulkDeleteRunsBatch removes up to limit of the JobRuns matching the query,
+// oldest first, and their related records: TaskRuns, RunResults and
+// RunRequests. It returns the number of JobRuns removed.
 //
 // TaskRuns are removed by ON DELETE CASCADE when the JobRuns are
 // deleted, but RunResults are not using foreign keys because multiple foreign
 // keys on a record creates an ambiguity with gorm.
-func (orm *ORM) BulkDeleteRuns(bulkQuery *models.BulkDeleteRunRequest) error {
-	orm.MustEnsureAdvisoryLock()
-	return orm.convenientTransaction(func(dbtx *gorm.DB) error {
+func (orm *ORM) BulkDeleteRunsBatch(bulkQuery *models.BulkDeleteRunRequest, limit int) (int, error) {
+	var deleted int
+	err := orm.convenientTransaction(func(dbtx *gorm.DB) error {
+		var ids []string
+		err := bulkDeleteRunsScope(dbtx, bulkQuery).
+			Order("updated_at asc").
+			Limit(limit).
+			Pluck("id", &ids).
+			Error
+		if err != nil {
+			return errors.Wrap(err, "error finding JobRuns to delete")
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
 		// NOTE: SQLite doesn't support compound delete statements, so delete run
 		// results for job_runs ...
-		err := dbtx.Exec(`
+		err = dbtx.Exec(`
 			DELETE
 			FROM run_results
 			WHERE run_results.id IN (SELECT result_id
 															FROM job_runs
-															WHERE status IN (?) AND updated_at < ?)`,
-			bulkQuery.Status.ToStrings(), bulkQuery.UpdatedBefore).Error
+															WHERE id IN (?))`, ids).Error
 		if err != nil {
 			return errors.Wrap(err, "error deleting JobRun's RunResults")
 		}
@@ -1134,29 +1506,24 @@ func (orm *ORM) BulkDeleteRuns(bulkQuery *models.BulkDeleteRunRequest) error {
 			FROM run_requests
 			WHERE run_requests.id IN (SELECT run_request_id
 															FROM job_runs
-															WHERE status IN (?) AND updated_at < ?)`,
-			bulkQuery.Status.ToStrings(), bulkQuery.UpdatedBefore).Error
+															WHERE id IN (?))`, ids).Error
 		if err != nil {
 			return errors.Wrap(err, "error deleting JobRun's RunRequests")
 		}
 
-		// and then task runs using a join in the subquery
+		// and then the results of their task runs
 		err = dbtx.Exec(`
 			DELETE
 			FROM run_results
-			WHERE run_results.id IN (SELECT task_runs.result_id
+			WHERE run_results.id IN (SELECT result_id
 															FROM task_runs
-															INNER JOIN job_runs ON
-																task_runs.job_run_id = job_runs.id
-															WHERE job_runs.status IN (?) AND job_runs.updated_at < ?)`,
-			bulkQuery.Status.ToStrings(), bulkQuery.UpdatedBefore).Error
+															WHERE job_run_id IN (?))`, ids).Error
 		if err != nil {
 			return errors.Wrap(err, "error deleting TaskRuns's RunResults")
 		}
 
 		err = dbtx.
-			Where("status IN (?)", bulkQuery.Status.ToStrings()).
-			Where("updated_at < ?", bulkQuery.UpdatedBefore).
+			Where("id IN (?)", ids).
 			Unscoped().
 			Delete(&[]models.JobRun{}).
 			Error
@@ -1164,8 +1531,61 @@ func (orm *ORM) BulkDeleteRuns(bulkQuery *models.BulkDeleteRunRequest) error {
 			return errors.Wrap(err, "error deleting JobRuns")
 		}
 
+		deleted = len(ids)
 		return nil
 	})
+	return deleted, err
+}
+
+// CountBulkDeleteRuns returns the number of JobRuns matching the query.
+func (orm *ORM) CountBulkDeleteRuns(bulkQuery *models.BulkDeleteRunRequest) (int, error) {
+	orm.MustEnsureAdvisoryLock()
+	var count int
+	return count, bulkDeleteRunsScope(orm.db, bulkQuery).Count(&count).Error
+}
+
+func bulkDeleteRunsScope(db *gorm.DB, bulkQuery *models.BulkDeleteRunRequest) *gorm.DB {
+	scope := db.Model(&models.JobRun{}).
+		Unscoped().
+		Where("status IN (?)", bulkQuery.Status.ToStrings()).
+		Where("updated_at < ?", bulkQuery.UpdatedBefore)
+	if bulkQuery.JobSpecID != nil {
+		scope = scope.Where("job_spec_id = ?", bulkQuery.JobSpecID)
+	}
+	return scope
+}
+
+// CreateBulkDeleteRunTask saves the task along with its query.
+func (orm *ORM) CreateBulkDeleteRunTask(task *models.BulkDeleteRunTask) error {
+	orm.MustEnsureAdvisoryLock()
+	return orm.db.Create(task).Error
+}
+
+// SaveBulkDeleteRunTask updates the status and progress of the task.
+func (orm *ORM) SaveBulkDeleteRunTask(task *models.BulkDeleteRunTask) error {
+	orm.MustEnsureAdvisoryLock()
+	return orm.db.Omit("Query").Save(task).Error
+}
+
+// FindBulkDeleteRunTask looks up a task by its ID.
+func (orm *ORM) FindBulkDeleteRunTask(id *models.ID) (*models.BulkDeleteRunTask, error) {
+	orm.MustEnsureAdvisoryLock()
+	task := &models.BulkDeleteRunTask{}
+	return task, orm.db.Preload("Query").First(task, "id = ?", id).Error
+}
+
+// BulkDeleteRunTasksInProgress returns the tasks that have yet to delete
+// everything their query matches, oldest first.
+func (orm *ORM) BulkDeleteRunTasksInProgress() ([]models.BulkDeleteRunTask, error) {
+	orm.MustEnsureAdvisoryLock()
+	var tasks []models.BulkDeleteRunTask
+	err := orm.db.
+		Preload("Query").
+		Where("status = ?", models.BulkTaskStatusInProgress).
+		Order("created_at asc").
+		Find(&tasks).
+		Error
+	return tasks, err
 }
 
 // Keys returns all keys stored in the orm.
@@ -2,6 +2,7 @@ package orm_test
 
 import (
 	"encoding/hex"
+	"errors"
 	"io"
 	"math/big"
 	"os"
@@ -11,6 +12,7 @@ import (
 
 	"chainlink/core/adapters"
 	"chainlink/core/assets"
+	"chainlink/core/eth"
 	"chainlink/core/internal/cltest"
 	"chainlink/core/services"
 	"chainlink/core/services/synchronization"
@@ -108,6 +110,87 @@ func TestORM_ArchiveJob(t *testing.T) {
 	require.NoError(t, utils.JustError(orm.FindJobRun(run.ID)))
 }
 
+func TestORM_UpdateJob(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithSchedule("* * * * *")
+	job.Tasks = []models.TaskSpec{cltest.NewTask(t, "httpget")}
+	require.NoError(t, store.CreateJob(&job))
+
+	run := job.NewRun(job.Initiators[0])
+	require.NoError(t, store.CreateJobRun(&run))
+
+	updated := cltest.NewJobWithWebInitiator()
+	updated.ID = job.ID
+	updated.Tasks = []models.TaskSpec{cltest.NewTask(t, "noop"), cltest.NewTask(t, "noop")}
+	require.NoError(t, store.UpdateJob(&updated))
+	assert.Equal(t, uint32(2), updated.Version)
+
+	j, err := store.FindJob(job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), j.Version)
+	require.Len(t, j.Initiators, 1)
+	assert.Equal(t, models.InitiatorWeb, j.Initiators[0].Type)
+	require.Len(t, j.Tasks, 2)
+	assert.Equal(t, models.MustNewTaskType("noop"), j.Tasks[0].Type)
+
+	newRun := j.NewRun(j.Initiators[0])
+	assert.Equal(t, uint32(2), newRun.JobSpecVersion)
+
+	run, err = store.FindJobRun(run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), run.JobSpecVersion)
+	assert.Equal(t, models.InitiatorCron, run.Initiator.Type)
+	require.Len(t, run.TaskRuns, 1)
+	assert.Equal(t, models.MustNewTaskType("httpget"), run.TaskRuns[0].TaskSpec.Type)
+}
+
+func TestORM_UpdateJob_CarriesOverLogCursor(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithLogInitiator()
+	require.NoError(t, store.CreateJob(&job))
+	previous := job.Initiators[0]
+	cursor := models.NewLogCursor(previous.ID, big.NewInt(10))
+	cursor.Advance(eth.Log{BlockNumber: 12, Index: 3})
+	require.NoError(t, store.SaveLogCursor(cursor))
+
+	updated := cltest.NewJobWithLogInitiator()
+	updated.ID = job.ID
+	updated.Initiators[0].Address = previous.Address
+	require.NoError(t, store.UpdateJob(&updated))
+	initr := updated.Initiators[0]
+	require.NotEqual(t, previous.ID, initr.ID)
+
+	cursor, err := store.FindLogCursor(initr.ID)
+	require.NoError(t, err)
+	require.NotNil(t, cursor)
+	assert.Equal(t, int64(12), cursor.BlockNumber)
+	assert.Equal(t, int64(3), cursor.LogIndex)
+
+	cursor, err = store.FindLogCursor(previous.ID)
+	require.NoError(t, err)
+	assert.Nil(t, cursor)
+}
+
+func TestORM_UpdateJob_NotFound(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.CreateJob(&job))
+	require.NoError(t, store.ArchiveJob(job.ID))
+
+	updated := cltest.NewJobWithWebInitiator()
+	updated.ID = job.ID
+	assert.Equal(t, orm.ErrorNotFound, store.UpdateJob(&updated))
+}
+
 func TestORM_CreateJobRun_CreatesRunRequest(t *testing.T) {
 	t.Parallel()
 	store, cleanup := cltest.NewStore(t)
@@ -277,6 +360,208 @@ func TestORM_JobRunsSortedFor(t *testing.T) {
 	assert.Equal(t, []*models.ID{jr2.ID, jr1.ID}, actual)
 }
 
+func TestORM_JobRunsFiltered(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	webJob := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.CreateJob(&webJob))
+	runLogJob := cltest.NewJobWithRunLogInitiator()
+	require.NoError(t, store.CreateJob(&runLogJob))
+
+	now := time.Now()
+	completed := webJob.NewRun(webJob.Initiators[0])
+	completed.CreatedAt = now.Add(-3 * time.Hour)
+	completed.Status = models.RunStatusCompleted
+	completed.FinishedAt = null.TimeFrom(now.Add(-2 * time.Hour))
+	require.NoError(t, store.CreateJobRun(&completed))
+
+	requester := cltest.NewAddress()
+	txHash := cltest.NewHash()
+	requestID := "0x0000000000000000000000000000000000000000000000000000000000000001"
+	errored := runLogJob.NewRun(runLogJob.Initiators[0])
+	errored.CreatedAt = now.Add(-time.Hour)
+	errored.RunRequest.Requester = &requester
+	errored.RunRequest.TxHash = &txHash
+	errored.RunRequest.RequestID = &requestID
+	errored.SetError(errors.New("price_feed 100% down"))
+	require.NoError(t, store.CreateJobRun(&errored))
+
+	pending := runLogJob.NewRun(runLogJob.Initiators[0])
+	pending.CreatedAt = now
+	pending.Status = models.RunStatusPendingBridge
+	require.NoError(t, store.CreateJobRun(&pending))
+
+	otherHash := cltest.NewHash()
+	tests := []struct {
+		name   string
+		filter models.JobRunFilter
+		want   []*models.ID
+	}{
+		{"none", models.JobRunFilter{}, []*models.ID{completed.ID, errored.ID, pending.ID}},
+		{"job spec", models.JobRunFilter{JobSpecID: runLogJob.ID}, []*models.ID{errored.ID, pending.ID}},
+		{"statuses", models.JobRunFilter{Statuses: []models.RunStatus{models.RunStatusCompleted, models.RunStatusErrored}}, []*models.ID{completed.ID, errored.ID}},
+		{"initiator type", models.JobRunFilter{InitiatorType: models.InitiatorWeb}, []*models.ID{completed.ID}},
+		{"created range", models.JobRunFilter{CreatedAfter: now.Add(-2 * time.Hour), CreatedBefore: now.Add(-time.Minute)}, []*models.ID{errored.ID}},
+		{"finished range", models.JobRunFilter{FinishedBefore: now.Add(-time.Hour)}, []*models.ID{completed.ID}},
+		{"finished after", models.JobRunFilter{FinishedAfter: now.Add(-time.Hour)}, []*models.ID{errored.ID}},
+		{"requester", models.JobRunFilter{Requester: &requester}, []*models.ID{errored.ID}},
+		{"request ID", models.JobRunFilter{RequestID: requestID}, []*models.ID{errored.ID}},
+		{"tx hash", models.JobRunFilter{TxHash: &txHash}, []*models.ID{errored.ID}},
+		{"other tx hash", models.JobRunFilter{TxHash: &otherHash}, []*models.ID{}},
+		{"error", models.JobRunFilter{Error: "100% down"}, []*models.ID{errored.ID}},
+		{"error wildcards are literal", models.JobRunFilter{Error: "price%feed"}, []*models.ID{}},
+		{"combined", models.JobRunFilter{JobSpecID: runLogJob.ID, Statuses: []models.RunStatus{models.RunStatusPendingBridge}}, []*models.ID{pending.ID}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runs, count, err := store.JobRunsFiltered(test.filter, orm.Ascending, 0, 100)
+			require.NoError(t, err)
+			assert.Equal(t, len(test.want), count)
+			ids := []*models.ID{}
+			for _, run := range runs {
+				ids = append(ids, run.ID)
+			}
+			assert.Equal(t, test.want, ids)
+		})
+	}
+
+	runs, count, err := store.JobRunsFiltered(models.JobRunFilter{}, orm.Descending, 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	require.Len(t, runs, 1)
+	assert.Equal(t, errored.ID, runs[0].ID)
+	assert.Equal(t, requester, *runs[0].RunRequest.Requester)
+}
+
+func TestORM_UnfinishedJobRunIDs(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithLogInitiator()
+	require.NoError(t, store.CreateJob(&job))
+	otherJob := cltest.NewJobWithLogInitiator()
+	require.NoError(t, store.CreateJob(&otherJob))
+
+	txHash := cltest.NewHash()
+	blockHash := cltest.NewHash()
+	otherBlockHash := cltest.NewHash()
+	createRun := func(job models.JobSpec, blockHash common.Hash, status models.RunStatus) *models.ID {
+		run := job.NewRun(job.Initiators[0])
+		run.Status = status
+		run.RunRequest.TxHash = &txHash
+		run.RunRequest.BlockHash = &blockHash
+		require.NoError(t, store.CreateJobRun(&run))
+		return run.ID
+	}
+
+	pending := createRun(job, blockHash, models.RunStatusPendingConfirmations)
+	createRun(job, blockHash, models.RunStatusCompleted)
+	other := createRun(otherJob, blockHash, models.RunStatusInProgress)
+	createRun(job, otherBlockHash, models.RunStatusInProgress)
+
+	ids, err := store.UnfinishedJobRunIDsInBlocks([]common.Hash{blockHash, cltest.NewHash()})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []*models.ID{pending, other}, ids)
+
+	ids, err = store.UnfinishedJobRunIDsForLog(job.ID, txHash, blockHash)
+	require.NoError(t, err)
+	assert.Equal(t, []*models.ID{pending}, ids)
+
+	ids, err = store.UnfinishedJobRunIDsForLog(job.ID, cltest.NewHash(), blockHash)
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+}
+
+func TestORM_LogRunRequested(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithLogInitiator()
+	require.NoError(t, store.CreateJob(&job))
+	initr := job.Initiators[0]
+
+	log := eth.Log{BlockNumber: 10, BlockHash: cltest.NewHash(), TxHash: cltest.NewHash(), Index: 2}
+	le := models.InitiatorLogEvent{JobSpecID: *job.ID, Initiator: initr, Log: log}
+	rr, err := le.RunRequest()
+	require.NoError(t, err)
+
+	requested, err := store.LogRunRequested(rr)
+	require.NoError(t, err)
+	assert.False(t, requested)
+
+	run := job.NewRun(initr)
+	run.RunRequest = rr
+	require.NoError(t, store.CreateJobRun(&run))
+
+	requested, err = store.LogRunRequested(rr)
+	require.NoError(t, err)
+	assert.True(t, requested)
+
+	otherInitiatorID := initr.ID + 1
+	otherInitiator := rr
+	otherInitiator.InitiatorID = &otherInitiatorID
+	requested, err = store.LogRunRequested(otherInitiator)
+	require.NoError(t, err)
+	assert.True(t, requested)
+
+	otherIndex := log.Index + 1
+	other := rr
+	other.LogIndex = &otherIndex
+	requested, err = store.LogRunRequested(other)
+	require.NoError(t, err)
+	assert.False(t, requested)
+
+	otherJob := rr
+	otherJob.JobSpecID = models.NewID()
+	requested, err = store.LogRunRequested(otherJob)
+	require.NoError(t, err)
+	assert.False(t, requested)
+
+	requested, err = store.LogRunRequested(models.RunRequest{})
+	require.NoError(t, err)
+	assert.False(t, requested)
+
+	duplicate := job.NewRun(initr)
+	duplicate.RunRequest = rr
+	assert.Error(t, store.CreateJobRun(&duplicate))
+}
+
+func TestORM_LogCursor(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithLogInitiator()
+	require.NoError(t, store.CreateJob(&job))
+	initr := job.Initiators[0]
+
+	cursor, err := store.FindLogCursor(initr.ID)
+	require.NoError(t, err)
+	assert.Nil(t, cursor)
+
+	require.NoError(t, store.SaveLogCursor(models.NewLogCursor(initr.ID, big.NewInt(10))))
+	cursor, err = store.FindLogCursor(initr.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), cursor.BlockNumber)
+	assert.Equal(t, int64(-1), cursor.LogIndex)
+
+	cursor.Advance(eth.Log{BlockNumber: 12, Index: 3})
+	require.NoError(t, store.SaveLogCursor(cursor))
+	cursor, err = store.FindLogCursor(initr.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), cursor.BlockNumber)
+	assert.Equal(t, int64(3), cursor.LogIndex)
+}
+
 func TestORM_UnscopedJobRunsWithStatus_Happy(t *testing.T) {
 	t.Parallel()
 	store, cleanup := cltest.NewStore(t)
@@ -734,7 +1019,7 @@ func TestORM_PendingBridgeType_alreadyCompleted(t *testing.T) {
 
 	cltest.WaitForJobRunStatus(t, store, run, models.RunStatusCompleted)
 
-	_, err := store.PendingBridgeType(run)
+	_, err := store.PendingBridgeType(run, nil)
 	assert.Error(t, err)
 }
 
@@ -753,7 +1038,7 @@ func TestORM_PendingBridgeType_success(t *testing.T) {
 	initr := job.Initiators[0]
 
 	unfinishedRun := job.NewRun(initr)
-	retrievedBt, err := store.PendingBridgeType(unfinishedRun)
+	retrievedBt, err := store.PendingBridgeType(unfinishedRun, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, retrievedBt, *bt)
 }
@@ -1095,6 +1380,83 @@ func TestBulkDeleteRuns(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestORM_BulkDeleteRunsBatch(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{{Type: adapters.TaskTypeNoOp}}
+	require.NoError(t, store.CreateJob(&job))
+	otherJob := cltest.NewJobWithWebInitiator()
+	otherJob.Tasks = []models.TaskSpec{{Type: adapters.TaskTypeNoOp}}
+	require.NoError(t, store.CreateJob(&otherJob))
+
+	for _, j := range []models.JobSpec{job, job, job, otherJob} {
+		run := j.NewRun(j.Initiators[0])
+		run.Status = models.RunStatusCompleted
+		require.NoError(t, store.CreateJobRun(&run))
+	}
+
+	query := &models.BulkDeleteRunRequest{
+		Status:        models.RunStatusCollection{models.RunStatusCompleted},
+		UpdatedBefore: time.Now().Add(time.Minute),
+		JobSpecID:     job.ID,
+	}
+	count, err := store.CountBulkDeleteRuns(query)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+
+	deleted, err := store.BulkDeleteRunsBatch(query, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 2, deleted)
+	deleted, err = store.BulkDeleteRunsBatch(query, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+	deleted, err = store.BulkDeleteRunsBatch(query, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 0, deleted)
+
+	count, err = store.JobRunsCountFor(otherJob.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestORM_AuditLogEntries(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	for _, action := range []models.AuditAction{models.AuditJobCreated, models.AuditBridgeCreated, models.AuditJobArchived} {
+		entry, err := models.NewAuditLogEntry(models.AuditActorSession, "user@example.com", action, "target", map[string]string{"secret": "shh"})
+		require.NoError(t, err)
+		require.NoError(t, store.CreateAuditLogEntry(&entry))
+	}
+
+	entries, count, err := store.AuditLogEntries("", 0, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	require.Len(t, entries, 2)
+	assert.Equal(t, models.AuditJobArchived, entries[0].Action)
+	assert.Equal(t, models.AuditBridgeCreated, entries[1].Action)
+	assert.Equal(t, `{"secret":"[REDACTED]"}`, entries[0].Payload.String())
+
+	entries, count, err = store.AuditLogEntries(models.AuditJobCreated, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "user@example.com", entries[0].Actor)
+
+	err = store.ORM.RawDB(func(db *gorm.DB) error {
+		assert.Error(t, db.Model(&entries[0]).UpdateColumn("actor", "someone@example.com").Error)
+		assert.Error(t, db.Delete(&entries[0]).Error)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
 func TestORM_FindTxAttempt_CurrentAttempt(t *testing.T) {
 	t.Parallel()
 
@@ -16,19 +16,44 @@ import (
 type ConfigSchema struct {
 	AllowOrigins              string         `env:"ALLOW_ORIGINS" default:"http://localhost:3000,http://localhost:6688"`
 	BridgeResponseURL         url.URL        `env:"BRIDGE_RESPONSE_URL"`
+	BulkDeleteBatchSize       uint           `env:"BULK_DELETE_BATCH_SIZE" default:"1000"`
 	ChainID                   big.Int        `env:"ETH_CHAIN_ID" default:"0"`
 	ClientNodeURL             string         `env:"CLIENT_NODE_URL" default:"http://localhost:6688"`
 	DatabaseTimeout           time.Duration  `env:"DATABASE_TIMEOUT" default:"500ms"`
 	DatabaseURL               string         `env:"DATABASE_URL"`
 	DefaultHTTPLimit          int64          `env:"DEFAULT_HTTP_LIMIT" default:"32768"`
+	DefaultHTTPTimeout        time.Duration  `env:"DEFAULT_HTTP_TIMEOUT" default:"15s"`
+	DefaultTaskTimeout        time.Duration  `env:"DEFAULT_TASK_TIMEOUT" default:"0s"`
 	Dev                       bool           `env:"CHAINLINK_DEV" default:"false"`
 	FeatureExternalInitiators bool           `env:"FEATURE_EXTERNAL_INITIATORS" default:"false"`
+	HTTPAllowedCIDRs          string         `env:"HTTP_ALLOWED_CIDRS"`
+	HTTPAllowedHosts          string         `env:"HTTP_ALLOWED_HOSTS"`
+	HTTPBlockedCIDRs          string         `env:"HTTP_BLOCKED_CIDRS"`
+	HTTPBlockedHosts          string         `env:"HTTP_BLOCKED_HOSTS"`
+	HTTPCABundlePath          string         `env:"HTTP_CA_BUNDLE_PATH"`
+	HTTPClientCertPath        string         `env:"HTTP_CLIENT_CERT_PATH"`
+	HTTPClientKeyPath         string         `env:"HTTP_CLIENT_KEY_PATH"`
+	HTTPIdleConnTimeout       time.Duration  `env:"HTTP_IDLE_CONN_TIMEOUT" default:"90s"`
+	HTTPMaxIdleConns          int            `env:"HTTP_MAX_IDLE_CONNS" default:"100"`
+	HTTPMaxIdleConnsPerHost   int            `env:"HTTP_MAX_IDLE_CONNS_PER_HOST" default:"10"`
+	HTTPProxyURL              *url.URL       `env:"HTTP_PROXY_URL"`
 	MaximumServiceDuration    time.Duration  `env:"MAXIMUM_SERVICE_DURATION" default:"8760h" `
 	MinimumServiceDuration    time.Duration  `env:"MINIMUM_SERVICE_DURATION" default:"0s" `
+	EthBroadcastTransactions  bool           `env:"ETH_BROADCAST_TRANSACTIONS" default:"false"`
+	EthGasBumpPercent         uint           `env:"ETH_GAS_BUMP_PERCENT" default:"0"`
 	EthGasBumpThreshold       uint64         `env:"ETH_GAS_BUMP_THRESHOLD" default:"12" `
 	EthGasBumpWei             big.Int        `env:"ETH_GAS_BUMP_WEI" default:"5000000000"`
 	EthGasPriceDefault        big.Int        `env:"ETH_GAS_PRICE_DEFAULT" default:"20000000000"`
+	EthGasEstimator           string         `env:"ETH_GAS_ESTIMATOR" default:"fixed"`
+	EthGasPriceBlockHistory   uint           `env:"ETH_GAS_PRICE_BLOCK_HISTORY" default:"20"`
+	EthGasPricePercentile     uint           `env:"ETH_GAS_PRICE_PERCENTILE" default:"60"`
+	EthHealthCheckInterval    time.Duration  `env:"ETH_HEALTH_CHECK_INTERVAL" default:"15s"`
+	EthMaxGasPriceWei         big.Int        `env:"ETH_MAX_GAS_PRICE_WEI" default:"1500000000000"`
+	EthMaxHeadLag             uint64         `env:"ETH_MAX_HEAD_LAG" default:"5"`
+	EthMinGasPriceWei         big.Int        `env:"ETH_MIN_GAS_PRICE_WEI" default:"0"`
+	EthPollInterval           time.Duration  `env:"ETH_POLL_INTERVAL" default:"5s"`
 	EthereumURL               string         `env:"ETH_URL" default:"ws://localhost:8546"`
+	EthereumSecondaryURLs     string         `env:"ETH_SECONDARY_URLS"`
 	JSONConsole               bool           `env:"JSON_CONSOLE" default:"false"`
 	LinkContractAddress       string         `env:"LINK_CONTRACT_ADDRESS" default:"0x514910771AF9Ca656af840dff83E8264EcF986CA"`
 	ExplorerURL               *url.URL       `env:"EXPLORER_URL"`
@@ -38,6 +63,7 @@ type ConfigSchema struct {
 	LogToDisk                 bool           `env:"LOG_TO_DISK" default:"true"`
 	LogSQLStatements          bool           `env:"LOG_SQL" default:"false"`
 	LogSQLMigrations          bool           `env:"LOG_SQL_MIGRATIONS" default:"true"`
+	MetricsEnabled            bool           `env:"METRICS_ENABLED" default:"false"`
 	MinIncomingConfirmations  uint32         `env:"MIN_INCOMING_CONFIRMATIONS" default:"3"`
 	MinOutgoingConfirmations  uint64         `env:"MIN_OUTGOING_CONFIRMATIONS" default:"12"`
 	MinimumContractPayment    assets.Link    `env:"MINIMUM_CONTRACT_PAYMENT" default:"1000000000000000000"`
@@ -48,6 +74,7 @@ type ConfigSchema struct {
 	ReaperExpiration          time.Duration  `env:"REAPER_EXPIRATION" default:"240h"`
 	ReplayFromBlock           int64          `env:"REPLAY_FROM_BLOCK" default:"-1"`
 	RootDir                   string         `env:"ROOT" default:"~/.chainlink"`
+	RunRetentionPeriod        time.Duration  `env:"RUN_RETENTION_PERIOD" default:"0s"`
 	SecureCookies             bool           `env:"SECURE_COOKIES" default:"true"`
 	SessionTimeout            time.Duration  `env:"SESSION_TIMEOUT" default:"15m"`
 	TLSCertPath               string         `env:"TLS_CERT_PATH" `
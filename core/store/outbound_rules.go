@@ -0,0 +1,226 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+
+	"chainlink/core/store/orm"
+
+	"github.com/pkg/errors"
+)
+
+// OutboundConnectionError is returned when an outbound HTTP request would
+// connect to a host or address that the node's configuration doesn't allow.
+type OutboundConnectionError struct {
+	Host string
+	IP   net.IP
+}
+
+func (e *OutboundConnectionError) Error() string {
+	if e.IP == nil {
+		return fmt.Sprintf("connections to host %s are not allowed by the node's outbound network configuration", e.Host)
+	}
+	return fmt.Sprintf("connections to %s (%s) are not allowed by the node's outbound network configuration", e.Host, e.IP)
+}
+
+// AsOutboundConnectionError returns the OutboundConnectionError behind err,
+// which the HTTP client wraps in a *url.Error and the dialer in a
+// *net.OpError.
+func AsOutboundConnectionError(err error) (*OutboundConnectionError, bool) {
+	for err != nil {
+		switch e := errors.Cause(err).(type) {
+		case *OutboundConnectionError:
+			return e, true
+		case *url.Error:
+			err = e.Err
+		case *net.OpError:
+			err = e.Err
+		default:
+			return nil, false
+		}
+	}
+	return nil, false
+}
+
+// outboundRules restricts the hosts and addresses that outbound HTTP
+// requests connect to. Addresses are checked once the host has been
+// resolved, so that a hostname can't be used to reach a blocked network.
+// Requests sent through a proxy are checked against both the proxy's address
+// and the requested host.
+type outboundRules struct {
+	allowedHosts []string
+	blockedHosts []string
+	allowedNets  []*net.IPNet
+	blockedNets  []*net.IPNet
+}
+
+func newOutboundRules(config orm.ConfigReader) (*outboundRules, error) {
+	allowedNets, err := parseCIDRs(config.HTTPAllowedCIDRs())
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid HTTP_ALLOWED_CIDRS")
+	}
+	blockedNets, err := parseCIDRs(config.HTTPBlockedCIDRs())
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid HTTP_BLOCKED_CIDRS")
+	}
+	return &outboundRules{
+		allowedHosts: splitList(config.HTTPAllowedHosts()),
+		blockedHosts: splitList(config.HTTPBlockedHosts()),
+		allowedNets:  allowedNets,
+		blockedNets:  blockedNets,
+	}, nil
+}
+
+func splitList(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.ToLower(strings.TrimSpace(item)); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func parseCIDRs(list string) ([]*net.IPNet, error) {
+	var nets []*net.IPNet
+	for _, cidr := range splitList(list) {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+		nets = append(nets, ipNet)
+	}
+	return nets, nil
+}
+
+// dialContext wraps dialer so that it refuses to connect to hosts and
+// addresses that the rules don't allow.
+func (r *outboundRules) dialContext(dialer *net.Dialer) func(context.Context, string, string) (net.Conn, error) {
+	return func(ctx context.Context, network, address string) (net.Conn, error) {
+		host, _, err := net.SplitHostPort(address)
+		if err != nil {
+			return nil, err
+		}
+		host = normalizeHost(host)
+		hostAllowed, err := r.checkHost(host)
+		if err != nil {
+			return nil, err
+		}
+
+		checked := *dialer
+		checked.Control = func(network, address string, c syscall.RawConn) error {
+			ipString, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(ipString)
+			if !r.allowIP(ip, hostAllowed) {
+				return &OutboundConnectionError{Host: host, IP: ip}
+			}
+			if dialer.Control != nil {
+				return dialer.Control(network, address, c)
+			}
+			return nil
+		}
+		return checked.DialContext(ctx, network, address)
+	}
+}
+
+// proxy wraps the transport's proxy func so that requests sent through a
+// proxy are checked against the rules as well, since the dialer only sees
+// the proxy's address. The requested host is resolved here to check its
+// addresses, although the proxy then resolves it again itself.
+func (r *outboundRules) proxy(proxy func(*http.Request) (*url.URL, error)) func(*http.Request) (*url.URL, error) {
+	return func(req *http.Request) (*url.URL, error) {
+		proxyURL, err := proxy(req)
+		if err != nil || proxyURL == nil {
+			return proxyURL, err
+		}
+		if err := r.checkProxiedHost(req.Context(), normalizeHost(req.URL.Hostname())); err != nil {
+			return nil, err
+		}
+		return proxyURL, nil
+	}
+}
+
+func (r *outboundRules) checkProxiedHost(ctx context.Context, host string) error {
+	hostAllowed, err := r.checkHost(host)
+	if err != nil {
+		return err
+	}
+
+	var ips []net.IP
+	if ip := net.ParseIP(host); ip != nil {
+		ips = []net.IP{ip}
+	} else if len(r.allowedNets) == 0 && len(r.blockedNets) == 0 {
+		if len(r.allowedHosts) > 0 && !hostAllowed {
+			return &OutboundConnectionError{Host: host}
+		}
+		return nil
+	} else {
+		addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+		if err != nil {
+			return errors.Wrapf(err, "unable to resolve %s to check it against the node's outbound network configuration", host)
+		}
+		for _, addr := range addrs {
+			ips = append(ips, addr.IP)
+		}
+	}
+
+	for _, ip := range ips {
+		if !r.allowIP(ip, hostAllowed) {
+			return &OutboundConnectionError{Host: host, IP: ip}
+		}
+	}
+	return nil
+}
+
+// checkHost returns an error if the host is blocked, and otherwise whether it
+// is explicitly allowed.
+func (r *outboundRules) checkHost(host string) (bool, error) {
+	if matchesHost(r.blockedHosts, host) {
+		return false, &OutboundConnectionError{Host: host}
+	}
+	return matchesHost(r.allowedHosts, host), nil
+}
+
+func normalizeHost(host string) string {
+	return strings.ToLower(strings.TrimSuffix(host, "."))
+}
+
+func (r *outboundRules) allowIP(ip net.IP, hostAllowed bool) bool {
+	if ip == nil || matchesNet(r.blockedNets, ip) {
+		return false
+	}
+	if len(r.allowedHosts) == 0 && len(r.allowedNets) == 0 {
+		return true
+	}
+	return hostAllowed || matchesNet(r.allowedNets, ip)
+}
+
+func matchesHost(patterns []string, host string) bool {
+	for _, pattern := range patterns {
+		if strings.HasPrefix(pattern, "*.") {
+			if strings.HasSuffix(host, pattern[1:]) {
+				return true
+			}
+		} else if host == pattern {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesNet(nets []*net.IPNet, ip net.IP) bool {
+	for _, ipNet := range nets {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -15,6 +15,7 @@ import (
 
 	"chainlink/core/assets"
 	"chainlink/core/auth"
+	"chainlink/core/eth"
 	"chainlink/core/logger"
 	"chainlink/core/store"
 	"chainlink/core/store/models"
@@ -127,15 +128,39 @@ type ConfigWhitelist struct {
 type Whitelist struct {
 	AllowOrigins             string          `json:"allowOrigins"`
 	BridgeResponseURL        string          `json:"bridgeResponseURL,omitempty"`
+	BulkDeleteBatchSize      uint            `json:"bulkDeleteBatchSize"`
 	ChainID                  *big.Int        `json:"ethChainId"`
 	ClientNodeURL            string          `json:"clientNodeUrl"`
 	DatabaseTimeout          time.Duration   `json:"databaseTimeout"`
+	DefaultHTTPTimeout       time.Duration   `json:"defaultHttpTimeout"`
+	DefaultTaskTimeout       time.Duration   `json:"defaultTaskTimeout"`
 	Dev                      bool            `json:"chainlinkDev"`
+	EthBroadcastTransactions bool            `json:"ethBroadcastTransactions"`
 	EthereumURL              string          `json:"ethUrl"`
+	EthereumSecondaryURLs    string          `json:"ethSecondaryUrls"`
+	EthGasBumpPercent        uint            `json:"ethGasBumpPercent"`
 	EthGasBumpThreshold      uint64          `json:"ethGasBumpThreshold"`
 	EthGasBumpWei            *big.Int        `json:"ethGasBumpWei"`
 	EthGasPriceDefault       *big.Int        `json:"ethGasPriceDefault"`
+	EthGasEstimator          string          `json:"ethGasEstimator"`
+	EthGasPriceBlockHistory  uint            `json:"ethGasPriceBlockHistory"`
+	EthGasPricePercentile    uint            `json:"ethGasPricePercentile"`
+	EthHealthCheckInterval   time.Duration   `json:"ethHealthCheckInterval"`
+	EthMaxGasPriceWei        *big.Int        `json:"ethMaxGasPriceWei"`
+	EthMaxHeadLag            uint64          `json:"ethMaxHeadLag"`
+	EthMinGasPriceWei        *big.Int        `json:"ethMinGasPriceWei"`
+	EthPollInterval          time.Duration   `json:"ethPollInterval"`
 	ExplorerURL              string          `json:"explorerUrl"`
+	HTTPAllowedCIDRs         string          `json:"httpAllowedCIDRs"`
+	HTTPAllowedHosts         string          `json:"httpAllowedHosts"`
+	HTTPBlockedCIDRs         string          `json:"httpBlockedCIDRs"`
+	HTTPBlockedHosts         string          `json:"httpBlockedHosts"`
+	HTTPCABundlePath         string          `json:"httpCABundlePath"`
+	HTTPClientCertPath       string          `json:"httpClientCertPath"`
+	HTTPIdleConnTimeout      time.Duration   `json:"httpIdleConnTimeout"`
+	HTTPMaxIdleConns         int             `json:"httpMaxIdleConns"`
+	HTTPMaxIdleConnsPerHost  int             `json:"httpMaxIdleConnsPerHost"`
+	HTTPProxyURL             string          `json:"httpProxyUrl"`
 	JSONConsole              bool            `json:"jsonConsole"`
 	LinkContractAddress      string          `json:"linkContractAddress"`
 	LogLevel                 orm.LogLevel    `json:"logLevel"`
@@ -143,6 +168,7 @@ type Whitelist struct {
 	LogSQLStatements         bool            `json:"logSqlStatements"`
 	LogToDisk                bool            `json:"logToDisk"`
 	MaxRPCCallsPerSecond     uint64          `json:"maxRPCCallsPerSecond"`
+	MetricsEnabled           bool            `json:"metricsEnabled"`
 	MinimumContractPayment   *assets.Link    `json:"minimumContractPayment"`
 	MinimumRequestExpiration uint64          `json:"minimumRequestExpiration"`
 	MinIncomingConfirmations uint32          `json:"minIncomingConfirmations"`
@@ -152,6 +178,7 @@ type Whitelist struct {
 	ReaperExpiration         time.Duration   `json:"reaperExpiration"`
 	ReplayFromBlock          int64           `json:"replayFromBlock"`
 	RootDir                  string          `json:"root"`
+	RunRetentionPeriod       time.Duration   `json:"runRetentionPeriod"`
 	SessionTimeout           time.Duration   `json:"sessionTimeout"`
 	TLSHost                  string          `json:"chainlinkTLSHost"`
 	TLSPort                  uint16          `json:"chainlinkTLSPort"`
@@ -171,27 +198,58 @@ func NewConfigWhitelist(store *store.Store) (ConfigWhitelist, error) {
 	if config.ExplorerURL() != nil {
 		explorerURL = config.ExplorerURL().String()
 	}
+	httpProxyURL := ""
+	if u := config.HTTPProxyURL(); u != nil {
+		withoutCredentials := *u
+		withoutCredentials.User = nil
+		httpProxyURL = withoutCredentials.String()
+	}
 	return ConfigWhitelist{
 		AccountAddress: account.Address.Hex(),
 		Whitelist: Whitelist{
 			AllowOrigins:             config.AllowOrigins(),
 			BridgeResponseURL:        config.BridgeResponseURL().String(),
+			BulkDeleteBatchSize:      config.BulkDeleteBatchSize(),
 			ChainID:                  config.ChainID(),
 			ClientNodeURL:            config.ClientNodeURL(),
 			Dev:                      config.Dev(),
 			DatabaseTimeout:          config.DatabaseTimeout(),
+			DefaultHTTPTimeout:       config.DefaultHTTPTimeout(),
+			DefaultTaskTimeout:       config.DefaultTaskTimeout(),
+			EthBroadcastTransactions: config.EthBroadcastTransactions(),
 			EthereumURL:              config.EthereumURL(),
+			EthereumSecondaryURLs:    config.EthereumSecondaryURLs(),
+			EthGasBumpPercent:        config.EthGasBumpPercent(),
 			EthGasBumpThreshold:      config.EthGasBumpThreshold(),
 			EthGasBumpWei:            config.EthGasBumpWei(),
 			EthGasPriceDefault:       config.EthGasPriceDefault(),
+			EthGasEstimator:          config.EthGasEstimator(),
+			EthGasPriceBlockHistory:  config.EthGasPriceBlockHistory(),
+			EthGasPricePercentile:    config.EthGasPricePercentile(),
+			EthHealthCheckInterval:   config.EthHealthCheckInterval(),
+			EthMaxGasPriceWei:        config.EthMaxGasPriceWei(),
+			EthMaxHeadLag:            config.EthMaxHeadLag(),
+			EthMinGasPriceWei:        config.EthMinGasPriceWei(),
+			EthPollInterval:          config.EthPollInterval(),
 			JSONConsole:              config.JSONConsole(),
 			LinkContractAddress:      config.LinkContractAddress(),
 			ExplorerURL:              explorerURL,
+			HTTPAllowedCIDRs:         config.HTTPAllowedCIDRs(),
+			HTTPAllowedHosts:         config.HTTPAllowedHosts(),
+			HTTPBlockedCIDRs:         config.HTTPBlockedCIDRs(),
+			HTTPBlockedHosts:         config.HTTPBlockedHosts(),
+			HTTPCABundlePath:         config.HTTPCABundlePath(),
+			HTTPClientCertPath:       config.HTTPClientCertPath(),
+			HTTPIdleConnTimeout:      config.HTTPIdleConnTimeout(),
+			HTTPMaxIdleConns:         config.HTTPMaxIdleConns(),
+			HTTPMaxIdleConnsPerHost:  config.HTTPMaxIdleConnsPerHost(),
+			HTTPProxyURL:             httpProxyURL,
 			LogLevel:                 config.LogLevel(),
 			LogToDisk:                config.LogToDisk(),
 			LogSQLStatements:         config.LogSQLStatements(),
 			LogSQLMigrations:         config.LogSQLMigrations(),
 			MaxRPCCallsPerSecond:     config.MaxRPCCallsPerSecond(),
+			MetricsEnabled:           config.MetricsEnabled(),
 			MinimumContractPayment:   config.MinimumContractPayment(),
 			MinimumRequestExpiration: config.MinimumRequestExpiration(),
 			MinIncomingConfirmations: config.MinIncomingConfirmations(),
@@ -201,6 +259,7 @@ func NewConfigWhitelist(store *store.Store) (ConfigWhitelist, error) {
 			ReaperExpiration:         config.ReaperExpiration(),
 			ReplayFromBlock:          config.ReplayFromBlock(),
 			RootDir:                  config.RootDir(),
+			RunRetentionPeriod:       config.RunRetentionPeriod(),
 			SessionTimeout:           config.SessionTimeout(),
 			TLSHost:                  config.TLSHost(),
 			TLSPort:                  config.TLSPort(),
@@ -682,3 +741,51 @@ func NewExplorerStatus(store *store.Store) ExplorerStatus {
 		Url:    url.String(),
 	}
 }
+
+// TaskType describes an adapter registered with the node and the defaults it
+// is run with.
+type TaskType struct {
+	Type               models.TaskType `json:"type"`
+	MinConfs           uint32          `json:"minConfs"`
+	MinContractPayment *assets.Link    `json:"minContractPayment"`
+	DevOnly            bool            `json:"devOnly"`
+}
+
+// GetID returns the jsonapi ID.
+func (t TaskType) GetID() string {
+	return t.Type.String()
+}
+
+// GetName returns the collection name for jsonapi.
+func (TaskType) GetName() string {
+	return "task_types"
+}
+
+// SetID is used to conform to the UnmarshallIdentifier interface for
+// deserializing from jsonapi documents.
+func (t *TaskType) SetID(value string) error {
+	t.Type = models.TaskType(value)
+	return nil
+}
+
+// EthEndpoint is a jsonapi wrapper for the health of an Ethereum node.
+type EthEndpoint struct {
+	eth.EndpointStatus
+}
+
+// GetID returns the jsonapi ID.
+func (e EthEndpoint) GetID() string {
+	return e.URL
+}
+
+// GetName returns the collection name for jsonapi.
+func (EthEndpoint) GetName() string {
+	return "eth_endpoints"
+}
+
+// SetID is used to conform to the UnmarshallIdentifier interface for
+// deserializing from jsonapi documents.
+func (e *EthEndpoint) SetID(value string) error {
+	e.URL = value
+	return nil
+}
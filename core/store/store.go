@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
@@ -29,12 +30,14 @@ import (
 // for keeping the application state in sync with the database.
 type Store struct {
 	*orm.ORM
-	Config      *orm.Config
-	Clock       utils.AfterNower
-	KeyStore    *KeyStore
-	TxManager   TxManager
-	StatsPusher *synchronization.StatsPusher
-	closeOnce   sync.Once
+	Config       *orm.Config
+	Clock        utils.AfterNower
+	KeyStore     *KeyStore
+	TxManager    TxManager
+	EthEndpoints *eth.EndpointPool
+	StatsPusher  *synchronization.StatsPusher
+	HTTPClients  *HTTPClientPool
+	closeOnce    sync.Once
 }
 
 type lazyRPCWrapper struct {
@@ -43,22 +46,27 @@ type lazyRPCWrapper struct {
 	mutex       *sync.Mutex
 	initialized *abool.AtomicBool
 	limiter     *rate.Limiter
+	poller      *eth.Poller
 }
 
-func newLazyRPCWrapper(urlString string, limiter *rate.Limiter) (eth.CallerSubscriber, error) {
+func newLazyRPCWrapper(urlString string, limiter *rate.Limiter, pollInterval time.Duration) (eth.CallerSubscriber, error) {
 	parsed, err := url.ParseRequestURI(urlString)
 	if err != nil {
 		return nil, err
 	}
-	if parsed.Scheme != "ws" && parsed.Scheme != "wss" {
-		return nil, fmt.Errorf("Ethereum url scheme must be websocket: %s", parsed.String())
+	switch parsed.Scheme {
+	case "ws", "wss", "http", "https":
+	default:
+		return nil, fmt.Errorf("Ethereum url scheme must be websocket or http: %s", parsed.String())
 	}
-	return &lazyRPCWrapper{
+	wrapper := &lazyRPCWrapper{
 		url:         parsed,
 		mutex:       &sync.Mutex{},
 		initialized: abool.New(),
 		limiter:     limiter,
-	}, nil
+	}
+	wrapper.poller = eth.NewPoller(wrapper, pollInterval)
+	return wrapper, nil
 }
 
 // lazyDialInitializer initializes the Dial instance used to interact with
@@ -96,11 +104,16 @@ func (wrapper *lazyRPCWrapper) Call(result interface{}, method string, args ...i
 	return wrapper.client.Call(result, method, args...)
 }
 
+// Subscribe subscribes over websockets, or emulates the subscription by
+// polling over HTTP, which has no notifications.
 func (wrapper *lazyRPCWrapper) Subscribe(ctx context.Context, channel interface{}, args ...interface{}) (eth.Subscription, error) {
 	err := wrapper.lazyDialInitializer()
 	if err != nil {
 		return nil, err
 	}
+	if wrapper.url.Scheme == "http" || wrapper.url.Scheme == "https" {
+		return wrapper.poller.Subscribe(channel, args...)
+	}
 	return wrapper.client.EthSubscribe(ctx, channel, args...)
 }
 
@@ -111,26 +124,29 @@ type Dialer interface {
 
 // EthDialer is Dialer which accesses rpc urls
 type EthDialer struct {
-	limiter *rate.Limiter
+	limiter      *rate.Limiter
+	pollInterval time.Duration
 }
 
-// NewEthDialer returns an eth dialer with the specified rate limit
-func NewEthDialer(rateLimit uint64) *EthDialer {
+// NewEthDialer returns an eth dialer with the specified rate limit, which
+// polls for subscriptions over http urls every pollInterval.
+func NewEthDialer(rateLimit uint64, pollInterval time.Duration) *EthDialer {
 	return &EthDialer{
-		limiter: rate.NewLimiter(rate.Limit(rateLimit), 1),
+		limiter:      rate.NewLimiter(rate.Limit(rateLimit), 1),
+		pollInterval: pollInterval,
 	}
 }
 
-// Dial will dial the given url and return a CallerSubscriber
+// Dial will dial the given websocket or http url and return a CallerSubscriber
 func (ed *EthDialer) Dial(urlString string) (eth.CallerSubscriber, error) {
-	return newLazyRPCWrapper(urlString, ed.limiter)
+	return newLazyRPCWrapper(urlString, ed.limiter, ed.pollInterval)
 }
 
 // NewStore will create a new database file at the config's RootDir if
 // it is not already present, otherwise it will use the existing db.sqlite3
 // file.
 func NewStore(config *orm.Config) *Store {
-	return NewStoreWithDialer(config, NewEthDialer(config.MaxRPCCallsPerSecond()))
+	return NewStoreWithDialer(config, NewEthDialer(config.MaxRPCCallsPerSecond(), config.EthPollInterval()))
 }
 
 // NewStoreWithDialer creates a new store with the given config and dialer
@@ -143,7 +159,7 @@ func NewStoreWithDialer(config *orm.Config, dialer Dialer) *Store {
 	if err != nil {
 		logger.Fatal(fmt.Sprintf("Unable to initialize ORM: %+v", err))
 	}
-	ethrpc, err := dialer.Dial(config.EthereumURL())
+	ethEndpoints, err := dialEthereumNodes(config, dialer)
 	if err != nil {
 		logger.Fatal(fmt.Sprintf("Unable to dial ETH RPC port: %+v", err))
 	}
@@ -153,19 +169,46 @@ func NewStoreWithDialer(config *orm.Config, dialer Dialer) *Store {
 	keyStore := NewKeyStore(config.KeysDir())
 
 	store := &Store{
-		Clock:       utils.Clock{},
-		Config:      config,
-		KeyStore:    keyStore,
-		ORM:         orm,
-		TxManager:   NewEthTxManager(&eth.CallerSubscriberClient{ethrpc}, config, keyStore, orm),
-		StatsPusher: synchronization.NewStatsPusher(orm, config.ExplorerURL(), config.ExplorerAccessKey(), config.ExplorerSecret()),
+		Clock:        utils.Clock{},
+		Config:       config,
+		KeyStore:     keyStore,
+		ORM:          orm,
+		TxManager:    NewEthTxManager(&eth.CallerSubscriberClient{ethEndpoints}, config, keyStore, orm),
+		EthEndpoints: ethEndpoints,
+		StatsPusher:  synchronization.NewStatsPusher(orm, config.ExplorerURL(), config.ExplorerAccessKey(), config.ExplorerSecret()),
+		HTTPClients:  NewHTTPClientPool(config),
 	}
 	return store
 }
 
+// dialEthereumNodes dials the primary and secondary Ethereum nodes, returning
+// a pool of them that fails over from one to the next.
+func dialEthereumNodes(config *orm.Config, dialer Dialer) (*eth.EndpointPool, error) {
+	pool := eth.NewEndpointPool(config.EthBroadcastTransactions(), config.EthMaxHeadLag())
+	primary, err := dialer.Dial(config.EthereumURL())
+	if err != nil {
+		return nil, err
+	}
+	pool.Add(config.EthereumURL(), true, primary)
+
+	for _, secondaryURL := range strings.Split(config.EthereumSecondaryURLs(), ",") {
+		secondaryURL = strings.TrimSpace(secondaryURL)
+		if secondaryURL == "" {
+			continue
+		}
+		secondary, err := dialer.Dial(secondaryURL)
+		if err != nil {
+			return nil, errors.Wrapf(err, "secondary Ethereum node %v", secondaryURL)
+		}
+		pool.Add(secondaryURL, false, secondary)
+	}
+	return pool, nil
+}
+
 // Start initiates all of Store's dependencies including the TxManager.
 func (s *Store) Start() error {
 	s.TxManager.Register(s.KeyStore.Accounts())
+	s.EthEndpoints.Start(s.Config.EthHealthCheckInterval())
 	return multierr.Combine(
 		s.SyncDiskKeyStoreToDB(),
 		s.StatsPusher.Start(),
@@ -176,8 +219,10 @@ func (s *Store) Start() error {
 func (s *Store) Close() error {
 	var err1, err2 error
 	s.closeOnce.Do(func() {
+		s.EthEndpoints.Stop()
 		err1 = s.StatsPusher.Close()
 		err2 = s.ORM.Close()
+		s.HTTPClients.CloseIdleConnections()
 	})
 	return multierr.Combine(err1, err2)
 }
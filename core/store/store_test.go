@@ -1,14 +1,21 @@
 package store_test
 
 import (
+	"encoding/json"
+	"fmt"
 	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"path/filepath"
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
+	"chainlink/core/eth"
 	"chainlink/core/internal/cltest"
 	"chainlink/core/internal/mocks"
+	strpkg "chainlink/core/store"
 	"chainlink/core/utils"
 
 	"github.com/stretchr/testify/assert"
@@ -149,3 +156,50 @@ func TestStore_SyncDiskKeyStoreToDB_DBKeyAlreadyExists(t *testing.T) {
 	require.Len(t, keys, 1)
 	require.Equal(t, acc.Address.Hex(), keys[0].Address.String())
 }
+
+func TestEthDialer_HTTP(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+
+		result := `null`
+		switch request.Method {
+		case "eth_chainId":
+			result = `"0x3"`
+		case "eth_getBlockByNumber":
+			result = `{"number": "0x2a", "hash": "0xbf9a7b2bf0a0c7d1ad3e1dd0f7d4ab2bbb4ab8d1f6d6e7b9c1fa3de4d24b8c3e"}`
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"jsonrpc": "2.0", "id": %s, "result": %s}`, request.ID, result)
+	}))
+	defer server.Close()
+
+	dialer := strpkg.NewEthDialer(100, 10*time.Millisecond)
+	caller, err := dialer.Dial(server.URL)
+	require.NoError(t, err)
+	client := &eth.CallerSubscriberClient{CallerSubscriber: caller}
+
+	chainID, err := client.GetChainID()
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(3), chainID)
+
+	heads := make(chan eth.BlockHeader)
+	sub, err := client.SubscribeToNewHeads(heads)
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	select {
+	case head := <-heads:
+		assert.Equal(t, int64(42), head.Number.ToInt().Int64())
+	case <-time.After(5 * time.Second):
+		t.Fatal("no head polled")
+	}
+
+	_, err = dialer.Dial("ftp://localhost:8545")
+	assert.Error(t, err)
+}
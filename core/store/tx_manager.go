@@ -4,12 +4,16 @@ import (
 	"fmt"
 	"math/big"
 	"regexp"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/gobuffalo/packr"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/tidwall/gjson"
 
 	"chainlink/core/assets"
@@ -36,6 +40,31 @@ const nonceReloadLimit int = 1
 // ErrPendingConnection is the error returned if TxManager is not connected.
 var ErrPendingConnection = errors.New("Cannot talk to chain, pending connection")
 
+var (
+	promGasBumps = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "tx_manager_gas_bumps_total",
+		Help: "The number of transaction attempts created with bumped gas",
+	})
+	promTxAttempts = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "tx_manager_tx_attempts",
+		Help:    "The number of attempts needed for a transaction to become safe",
+		Buckets: prometheus.LinearBuckets(1, 1, 10),
+	})
+	promConfirmationLatency = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "tx_manager_confirmation_latency_seconds",
+		Help:    "The time from the first attempt of a transaction until it became safe",
+		Buckets: prometheus.ExponentialBuckets(15, 2, 10),
+	})
+	promETHBalance = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "eth_balance",
+		Help: "The ETH balance of each managed account, as of the latest head",
+	}, []string{"account"})
+	promLINKBalance = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "link_balance",
+		Help: "The LINK balance of each managed account, as of the latest head",
+	}, []string{"account"})
+)
+
 // TxManager represents an interface for interacting with the blockchain
 type TxManager interface {
 	HeadTrackable
@@ -77,6 +106,9 @@ type EthTxManager struct {
 	accountsMutex       *sync.Mutex
 	connected           *abool.AtomicBool
 	currentHead         models.Head
+	gasEstimator        GasEstimator
+	gasBumper           GasBumper
+	updatingBalances    *abool.AtomicBool
 }
 
 // NewEthTxManager constructs an EthTxManager using the passed variables and
@@ -89,6 +121,10 @@ func NewEthTxManager(client eth.Client, config orm.ConfigReader, keyStore *KeySt
 		orm:           orm,
 		accountsMutex: &sync.Mutex{},
 		connected:     abool.New(),
+		gasEstimator:  NewGasEstimator(client, config),
+		gasBumper:     NewGasBumper(config),
+
+		updatingBalances: abool.New(),
 	}
 }
 
@@ -166,25 +202,44 @@ func (txm *EthTxManager) Disconnect() {
 	txm.connected.UnSet()
 }
 
-// OnNewHead does nothing; exists to comply with interface.
+// OnNewHead records the current head, updates the balance metrics when
+// metrics are enabled, and passes the head on to the gas estimator if it
+// tracks heads.
 func (txm *EthTxManager) OnNewHead(head *models.Head) {
 	txm.currentHead = *head
+	if txm.config.MetricsEnabled() {
+		txm.updateBalanceMetrics()
+	}
+	if trackable, ok := txm.gasEstimator.(HeadTrackable); ok {
+		trackable.OnNewHead(head)
+	}
+}
+
+// OnReorg passes the reorganization on to the gas estimator if it tracks
+// heads. Receipts are checked against the chain on every new head, so
+// transactions reorged out are seen as unconfirmed again.
+func (txm *EthTxManager) OnReorg(reorg *models.Reorg) {
+	if trackable, ok := txm.gasEstimator.(HeadTrackable); ok {
+		trackable.OnReorg(reorg)
+	}
 }
 
 // CreateTx signs and sends a transaction to the Ethereum blockchain.
 func (txm *EthTxManager) CreateTx(to common.Address, data []byte) (*models.Tx, error) {
-	return txm.CreateTxWithGas(null.String{}, to, data, txm.config.EthGasPriceDefault(), DefaultGasLimit)
+	return txm.CreateTxWithGas(null.String{}, to, data, nil, DefaultGasLimit)
 }
 
 // CreateTxWithGas signs and sends a transaction to the Ethereum blockchain.
+// The gas price is estimated unless one is given, and is kept within the
+// configured floor and ceiling either way.
 func (txm *EthTxManager) CreateTxWithGas(surrogateID null.String, to common.Address, data []byte, gasPriceWei *big.Int, gasLimit uint64) (*models.Tx, error) {
 	ma, err := txm.nextAccount()
 	if err != nil {
 		return nil, err
 	}
 
-	gasPriceWei, gasLimit = normalizeGasParams(gasPriceWei, gasLimit, txm.config)
-	return txm.createTx(surrogateID, ma, to, data, gasPriceWei, gasLimit, nil)
+	gasLimit = normalizeGasLimit(gasLimit, txm.config)
+	return txm.createTx(surrogateID, ma, to, data, txm.gasPrice(gasPriceWei), gasLimit, nil)
 }
 
 // CreateTxWithEth signs and sends a transaction with some ETH to transfer.
@@ -194,7 +249,7 @@ func (txm *EthTxManager) CreateTxWithEth(from, to common.Address, value *assets.
 		return nil, errors.New("account does not exist")
 	}
 
-	return txm.createTx(null.String{}, ma, to, []byte{}, txm.config.EthGasPriceDefault(), DefaultGasLimit, value)
+	return txm.createTx(null.String{}, ma, to, []byte{}, txm.gasPrice(nil), DefaultGasLimit, value)
 }
 
 func (txm *EthTxManager) nextAccount() (*ManagedAccount, error) {
@@ -210,20 +265,25 @@ func (txm *EthTxManager) nextAccount() (*ManagedAccount, error) {
 	return ma, nil
 }
 
-func normalizeGasParams(gasPriceWei *big.Int, gasLimit uint64, config orm.ConfigReader) (*big.Int, uint64) {
-	if !config.Dev() {
-		return config.EthGasPriceDefault(), DefaultGasLimit
-	}
-
+// gasPrice returns the given gas price, or the estimated one when there is
+// none, within the configured floor and ceiling.
+func (txm *EthTxManager) gasPrice(gasPriceWei *big.Int) *big.Int {
 	if gasPriceWei == nil {
-		gasPriceWei = config.EthGasPriceDefault()
+		estimate, err := txm.gasEstimator.EstimateGasPrice()
+		if err != nil {
+			logger.Warnw("Unable to estimate gas price, using default", "err", err)
+			estimate = txm.config.EthGasPriceDefault()
+		}
+		gasPriceWei = estimate
 	}
+	return LimitGasPrice(gasPriceWei, txm.config)
+}
 
-	if gasLimit == 0 {
-		gasLimit = DefaultGasLimit
+func normalizeGasLimit(gasLimit uint64, config orm.ConfigReader) uint64 {
+	if !config.Dev() || gasLimit == 0 {
+		return DefaultGasLimit
 	}
-
-	return gasPriceWei, gasLimit
+	return gasLimit
 }
 
 // createTx creates an ethereum transaction, and retries to submit the
@@ -672,8 +732,14 @@ func (txm *EthTxManager) handleSafe(
 		return errors.Wrap(err, "handleSafe MarkTxSafe failed")
 	}
 
+	promTxAttempts.Observe(float64(len(tx.Attempts)))
+	if len(tx.Attempts) > 0 {
+		promConfirmationLatency.Observe(time.Since(tx.Attempts[0].CreatedAt).Seconds())
+	}
+
 	minimumConfirmations := txm.config.MinOutgoingConfirmations()
 	ethBalance, linkBalance, balanceErr := txm.GetETHAndLINKBalances(tx.From)
+	setBalanceMetrics(tx.From, ethBalance, linkBalance)
 
 	logger.Infow(
 		fmt.Sprintf("Tx #%d is safe", attemptIndex),
@@ -688,18 +754,68 @@ func (txm *EthTxManager) handleSafe(
 	return nil
 }
 
+// updateBalanceMetrics fetches the balances of the available accounts in the
+// background, unless an earlier update is still in progress, so that the
+// head tracker isn't held up by the calls to the node.
+func (txm *EthTxManager) updateBalanceMetrics() {
+	if !txm.updatingBalances.SetToIf(false, true) {
+		return
+	}
+
+	txm.accountsMutex.Lock()
+	addresses := make([]common.Address, len(txm.availableAccounts))
+	for i, a := range txm.availableAccounts {
+		addresses[i] = a.Address
+	}
+	txm.accountsMutex.Unlock()
+
+	go func() {
+		defer txm.updatingBalances.UnSet()
+		for _, address := range addresses {
+			ethBalance, linkBalance, err := txm.GetETHAndLINKBalances(address)
+			if err != nil {
+				logger.Warnw("Unable to fetch balances for metrics", "address", address.Hex(), "err", err)
+			}
+			setBalanceMetrics(address, ethBalance, linkBalance)
+		}
+	}()
+}
+
+func setBalanceMetrics(address common.Address, ethBalance *assets.Eth, linkBalance *assets.Link) {
+	if ethBalance != nil {
+		promETHBalance.WithLabelValues(address.Hex()).Set(currencyValue(ethBalance))
+	}
+	if linkBalance != nil {
+		promLINKBalance.WithLabelValues(address.Hex()).Set(currencyValue(linkBalance))
+	}
+}
+
+// currencyValue converts an amount of ETH or LINK to a float in whole units.
+func currencyValue(amount fmt.Stringer) float64 {
+	value, _ := strconv.ParseFloat(amount.String(), 64)
+	return value
+}
+
 // bumpGas creates a new transaction attempt with an increased gas cost
 func (txm *EthTxManager) bumpGas(tx *models.Tx, attemptIndex int, blockHeight uint64) error {
 	txAttempt := tx.Attempts[attemptIndex]
 
 	originalGasPrice := txAttempt.GasPrice.ToInt()
-	bumpedGasPrice := new(big.Int).Add(originalGasPrice, txm.config.EthGasBumpWei())
+	bumpedGasPrice := LimitGasPrice(txm.gasBumper.BumpGasPrice(originalGasPrice), txm.config)
+	if bumpedGasPrice.Cmp(originalGasPrice) <= 0 {
+		logger.Warnw(
+			fmt.Sprintf("Tx #%d not bumped, gas price %v is at the ceiling", attemptIndex, originalGasPrice),
+			"txHash", txAttempt.Hash,
+			"ceiling", txm.config.EthMaxGasPriceWei())
+		return nil
+	}
 
 	bumpedTxAttempt, err := txm.createAttempt(tx, bumpedGasPrice, blockHeight)
 	if err != nil {
 		return errors.Wrapf(err, "bumpGas from Tx #%s", txAttempt.Hash.Hex())
 	}
 
+	promGasBumps.Inc()
 	logger.Infow(
 		fmt.Sprintf("Tx #%d created with bumped gas %v", attemptIndex+1, bumpedGasPrice),
 		"originalTxHash", txAttempt.Hash,
@@ -19,6 +19,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/onsi/gomega"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -496,6 +497,43 @@ func TestTxManager_BumpGasUntilSafe_exceedsGasBumpThreshold(t *testing.T) {
 	ethMock.EventuallyAllCalled(t)
 }
 
+func TestTxManager_BumpGasUntilSafe_atGasPriceCeiling(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKey(t)
+	defer cleanup()
+
+	store := app.Store
+	config := store.Config
+
+	txm := store.TxManager
+	from := cltest.GetAccountAddress(t, store)
+	sentAt := uint64(23456)
+	gasThreshold := sentAt + config.EthGasBumpThreshold()
+	ethMock := app.MockCallerSubscriberClient(cltest.Strict)
+	ethMock.Register("eth_getTransactionCount", "0x0")
+	ethMock.Register("eth_chainId", config.ChainID())
+	require.NoError(t, app.Store.ORM.CreateHead(cltest.Head(gasThreshold)))
+	require.NoError(t, app.StartAndConnect())
+
+	tx := cltest.CreateTx(t, store, from, sentAt)
+	require.Greater(t, len(tx.Attempts), 0)
+	config.Set("ETH_MAX_GAS_PRICE_WEI", tx.Attempts[0].GasPrice.String())
+
+	ethMock.Register("eth_getTransactionReceipt", eth.TxReceipt{})
+
+	receipt, state, err := txm.BumpGasUntilSafe(tx.Attempts[0].Hash)
+	assert.NoError(t, err)
+	assert.Nil(t, receipt)
+	assert.Equal(t, strpkg.Unconfirmed, state)
+
+	tx, err = store.FindTx(tx.ID)
+	require.NoError(t, err)
+	assert.Len(t, tx.Attempts, 1)
+
+	ethMock.EventuallyAllCalled(t)
+}
+
 func TestTxManager_BumpGasUntilSafe_confirmed(t *testing.T) {
 	t.Parallel()
 
@@ -1077,6 +1115,8 @@ func TestTxManager_CreateTxWithGas(t *testing.T) {
 	customGasLimit := uint64(10009)
 
 	defaultGasPrice := utils.NewBig(config.EthGasPriceDefault())
+	ceilingGasPrice := utils.NewBig(big.NewInt(100000000000))
+	config.Set("ETH_MAX_GAS_PRICE_WEI", ceilingGasPrice.String())
 
 	tests := []struct {
 		name             string
@@ -1088,8 +1128,9 @@ func TestTxManager_CreateTxWithGas(t *testing.T) {
 	}{
 		{"dev", true, customGasPrice, customGasLimit, customGasPrice, customGasLimit},
 		{"dev but not set", true, nil, 0, defaultGasPrice, strpkg.DefaultGasLimit},
-		{"not dev", false, customGasPrice, customGasLimit, defaultGasPrice, strpkg.DefaultGasLimit},
+		{"not dev", false, customGasPrice, customGasLimit, customGasPrice, strpkg.DefaultGasLimit},
 		{"not dev not set", false, nil, 0, defaultGasPrice, strpkg.DefaultGasLimit},
+		{"above ceiling", false, utils.NewBig(big.NewInt(200000000000)), 0, ceilingGasPrice, strpkg.DefaultGasLimit},
 	}
 
 	for _, test := range tests {
@@ -1224,3 +1265,33 @@ func TestTxManager_RebroadcastUnconfirmedTxsOnReconnect(t *testing.T) {
 
 	ethClient.AssertExpectations(t)
 }
+
+func TestTxManager_OnNewHead_UpdatesBalanceMetrics(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	ethClient := new(mocks.Client)
+
+	config := cltest.NewTestConfig(t)
+	config.Set("METRICS_ENABLED", true)
+	keyStore := strpkg.NewKeyStore(config.KeysDir())
+	account, err := keyStore.NewAccount(cltest.Password)
+	require.NoError(t, err)
+	require.NoError(t, keyStore.Unlock(cltest.Password))
+	manager := strpkg.NewEthTxManager(ethClient, config, keyStore, store.ORM)
+	manager.Register(keyStore.Accounts())
+
+	ethClient.On("GetNonce", account.Address).Return(uint64(0), nil)
+	require.NoError(t, manager.Connect(cltest.Head(1)))
+
+	balanceFetched := make(chan struct{})
+	ethClient.On("GetERC20Balance", account.Address, mock.Anything).Return(big.NewInt(1), nil)
+	ethClient.On("GetEthBalance", account.Address).Return(assets.NewEth(1), nil).
+		Run(func(mock.Arguments) { close(balanceFetched) }).Once()
+
+	manager.OnNewHead(cltest.Head(2))
+	gomega.NewGomegaWithT(t).Eventually(balanceFetched).Should(gomega.BeClosed())
+	ethClient.AssertExpectations(t)
+}
@@ -10,4 +10,5 @@ type HeadTrackable interface {
 	Connect(*models.Head) error
 	Disconnect()
 	OnNewHead(*models.Head)
+	OnReorg(*models.Reorg)
 }
@@ -0,0 +1,38 @@
+package web
+
+import (
+	"chainlink/core/logger"
+	"chainlink/core/store"
+	"chainlink/core/store/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// auditActor returns who authenticated the request, and how.
+func auditActor(c *gin.Context) (models.AuditActorType, string) {
+	if ei, ok := authenticatedEI(c); ok {
+		return models.AuditActorExternalInitiator, ei.Name
+	}
+	user, ok := authenticatedUser(c)
+	if !ok {
+		return models.AuditActorSession, ""
+	}
+	if c.GetBool(SessionAPITokenKey) {
+		return models.AuditActorAPIToken, user.Email
+	}
+	return models.AuditActorSession, user.Email
+}
+
+// audit appends an entry for an action carried out by the request to the
+// audit log, redacting the payload. The action has already taken effect, so
+// failing to record it is logged rather than failing the request.
+func audit(c *gin.Context, store *store.Store, action models.AuditAction, target string, payload interface{}) {
+	actorType, actor := auditActor(c)
+	entry, err := models.NewAuditLogEntry(actorType, actor, action, target, payload)
+	if err == nil {
+		err = store.CreateAuditLogEntry(&entry)
+	}
+	if err != nil {
+		logger.Errorw("Unable to record audit log entry", "action", action, "target", target, "error", err)
+	}
+}
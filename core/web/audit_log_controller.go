@@ -0,0 +1,23 @@
+package web
+
+import (
+	"chainlink/core/services"
+	"chainlink/core/store/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AuditLogController lists the administrative actions taken on the node.
+type AuditLogController struct {
+	App services.Application
+}
+
+// Index returns a page of the audit log, newest first. The action query
+// parameter selects the entries for a single action.
+// Example:
+//  "<application>/audit_log?action=job.created"
+func (alc *AuditLogController) Index(c *gin.Context, size, page, offset int) {
+	action := models.AuditAction(c.Query("action"))
+	entries, count, err := alc.App.GetStore().AuditLogEntries(action, offset, size)
+	paginatedResponse(c, "AuditLog", size, page, entries, count, err)
+}
@@ -0,0 +1,92 @@
+package web_test
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"chainlink/core/internal/cltest"
+	"chainlink/core/store/models"
+	"chainlink/core/web"
+
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditLogController_Index(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t)
+	defer cleanup()
+	app.Start()
+	client := app.NewHTTPClient()
+	app.MustSeedUserAPIKey()
+
+	body := bytes.NewBufferString(`{"name":"auditedbridge","url":"http://mybridge"}`)
+	resp, cleanup := client.Post("/v2/bridge_types", body)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	request, err := http.NewRequest("PATCH", app.Server.URL+"/v2/bridge_types/auditedbridge", bytes.NewBufferString(`{"url":"http://mybridge","rotateSigningSecret":true}`))
+	require.NoError(t, err)
+	request.Header.Set(web.APIKey, cltest.APIKey)
+	request.Header.Set(web.APISecret, cltest.APISecret)
+	resp, err = http.DefaultClient.Do(request)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	resp, cleanup = client.Get("/v2/audit_log")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var entries []models.AuditLogEntry
+	require.NoError(t, web.ParsePaginatedResponse(cltest.ParseResponseBody(t, resp), &entries, &jsonapi.Links{}))
+	require.Len(t, entries, 2)
+
+	assert.Equal(t, models.AuditBridgeSecretRotated, entries[0].Action)
+	assert.Equal(t, models.AuditActorAPIToken, entries[0].ActorType)
+	assert.Equal(t, cltest.APIEmail, entries[0].Actor)
+	assert.Equal(t, "auditedbridge", entries[0].Target)
+	assert.True(t, entries[0].Payload.Get("rotateSigningSecret").Bool())
+
+	assert.Equal(t, models.AuditBridgeCreated, entries[1].Action)
+	assert.Equal(t, models.AuditActorSession, entries[1].ActorType)
+	assert.Equal(t, cltest.APIEmail, entries[1].Actor)
+	assert.Equal(t, "http://mybridge", entries[1].Payload.Get("url").String())
+
+	resp, cleanup = client.Get("/v2/audit_log?action=bridge.created")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	entries = nil
+	require.NoError(t, web.ParsePaginatedResponse(cltest.ParseResponseBody(t, resp), &entries, &jsonapi.Links{}))
+	require.Len(t, entries, 1)
+	assert.Equal(t, models.AuditBridgeCreated, entries[0].Action)
+}
+
+func TestAuditLogController_Index_RedactsPayload(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t)
+	defer cleanup()
+	app.Start()
+	client := app.NewHTTPClient()
+
+	resp, cleanup := client.Patch("/v2/user/password", bytes.NewBufferString(`{"oldPassword":"`+cltest.Password+`","newPassword":"bar"}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	resp, cleanup = client.Post("/v2/specs", bytes.NewBufferString(`{"initiators":[{"type":"web"}],"tasks":[{"type":"noop","params":{"apiSecret":"shh"}}]}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	entries, count, err := app.Store.AuditLogEntries("", 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	require.Len(t, entries, 2)
+	assert.Equal(t, models.AuditJobCreated, entries[0].Action)
+	assert.Equal(t, models.Redacted, entries[0].Payload.Get("tasks.0.params.apiSecret").String())
+	assert.Equal(t, models.AuditPasswordChanged, entries[1].Action)
+	assert.NotContains(t, entries[1].Payload.String(), "bar")
+}
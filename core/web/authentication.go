@@ -92,6 +92,7 @@ func AuthenticateByToken(store *store.Store, c *gin.Context) error {
 		return auth.ErrorAuthFailed
 	}
 	c.Set(SessionUserKey, &user)
+	c.Set(SessionAPITokenKey, true)
 	return nil
 }
 
@@ -30,6 +30,7 @@ func (btc *BridgeTypesController) Create(c *gin.Context) {
 	} else if err := btc.App.GetStore().CreateBridgeType(bt); err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 	} else {
+		audit(c, btc.App.GetStore(), models.AuditBridgeCreated, bt.Name.String(), btr)
 		jsonAPIResponse(c, bta, "bridge")
 	}
 }
@@ -70,6 +71,11 @@ func (btc *BridgeTypesController) Update(c *gin.Context) {
 	} else if err := btc.App.GetStore().UpdateBridgeType(&bt, btr); err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 	} else {
+		action := models.AuditBridgeUpdated
+		if btr.RotateSigningSecret {
+			action = models.AuditBridgeSecretRotated
+		}
+		audit(c, btc.App.GetStore(), action, bt.Name.String(), btr)
 		jsonAPIResponse(c, bt, "bridge")
 	}
 }
@@ -91,6 +97,7 @@ func (btc *BridgeTypesController) Destroy(c *gin.Context) {
 	} else if err = btc.App.GetStore().DeleteBridgeType(&bt); err != nil {
 		jsonAPIError(c, StatusCodeForError(err), fmt.Errorf("failed to initialise BTC Destroy: %+v", err))
 	} else {
+		audit(c, btc.App.GetStore(), models.AuditBridgeDeleted, bt.Name.String(), nil)
 		jsonAPIResponse(c, bt, "bridge")
 	}
 }
@@ -152,6 +152,65 @@ func TestBridgeTypesController_Update_Success(t *testing.T) {
 	assert.Equal(t, cltest.WebURL(t, "http://yourbridge"), ubt.URL)
 }
 
+func TestBridgeTypesController_Update_RotateSigningSecret(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	bt := &models.BridgeType{
+		Name: models.MustNewTaskType("signedbridge"),
+		URL:  cltest.WebURL(t, "http://mybridge"),
+	}
+	require.NoError(t, app.GetStore().CreateBridgeType(bt))
+
+	resp, cleanup := client.Patch("/v2/bridge_types/signedbridge", bytes.NewBufferString(`{"url":"http://mybridge","signed":true}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var signed models.BridgeType
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &signed))
+	require.NotEmpty(t, signed.SigningSecret)
+
+	resp, cleanup = client.Patch("/v2/bridge_types/signedbridge", bytes.NewBufferString(`{"url":"http://mybridge","rotateSigningSecret":true}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var rotated models.BridgeType
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &rotated))
+	assert.NotEmpty(t, rotated.SigningSecret)
+	assert.NotEqual(t, signed.SigningSecret, rotated.SigningSecret)
+
+	ubt, err := app.Store.FindBridge(bt.Name)
+	require.NoError(t, err)
+	assert.Equal(t, rotated.SigningSecret, ubt.SigningSecret)
+}
+
+func TestBridgeTypesController_Update_KeepsSigningSecret(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	bt := &models.BridgeType{
+		Name:          models.MustNewTaskType("signedbridge"),
+		URL:           cltest.WebURL(t, "http://mybridge"),
+		SigningSecret: "secret",
+	}
+	require.NoError(t, app.GetStore().CreateBridgeType(bt))
+
+	resp, cleanup := client.Patch("/v2/bridge_types/signedbridge", bytes.NewBufferString(`{"url":"http://yourbridge"}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	ubt, err := app.Store.FindBridge(bt.Name)
+	require.NoError(t, err)
+	assert.Equal(t, cltest.WebURL(t, "http://yourbridge"), ubt.URL)
+	assert.Equal(t, "secret", ubt.SigningSecret)
+}
+
 func TestBridgeController_Show(t *testing.T) {
 	t.Parallel()
 
@@ -5,8 +5,10 @@ import (
 
 	"chainlink/core/services"
 	"chainlink/core/store/models"
+	"chainlink/core/store/orm"
 
 	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
 )
 
 // BulkDeletesController manages background tasks that delete resources given a query
@@ -14,18 +16,50 @@ type BulkDeletesController struct {
 	App services.Application
 }
 
-// Delete removes all runs given a query
+// Delete starts deleting all runs given a query in the background, returning
+// the task that tracks the deletion's progress.
 // Example:
 //  "<application>/bulk_delete_runs"
 func (bdc *BulkDeletesController) Delete(c *gin.Context) {
 	request := &models.BulkDeleteRunRequest{}
 	if err := c.ShouldBindJSON(request); err != nil {
 		jsonAPIError(c, http.StatusBadRequest, err)
+		return
 	} else if err := models.ValidateBulkDeleteRunRequest(request); err != nil {
 		jsonAPIError(c, http.StatusUnprocessableEntity, err)
-	} else if err := bdc.App.GetStore().BulkDeleteRuns(request); err != nil {
+		return
+	}
+
+	store := bdc.App.GetStore()
+	total, err := store.CountBulkDeleteRuns(request)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	task := models.NewBulkDeleteRunTask(*request)
+	task.Total = total
+	if err := store.CreateBulkDeleteRunTask(task); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	audit(c, store, models.AuditRunsBulkDeleted, task.ID.String(), request)
+	bdc.App.WakeBulkRunDeleter()
+	jsonAPIResponseWithStatus(c, task, "bulk delete run task", http.StatusAccepted)
+}
+
+// Show returns the progress of a task deleting runs in the background.
+// Example:
+//  "<application>/bulk_delete_runs/:TaskID"
+func (bdc *BulkDeletesController) Show(c *gin.Context) {
+	if id, err := models.NewIDFromString(c.Param("TaskID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+	} else if task, err := bdc.App.GetStore().FindBulkDeleteRunTask(id); errors.Cause(err) == orm.ErrorNotFound {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Bulk delete run task not found"))
+	} else if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 	} else {
-		jsonAPIResponseWithStatus(c, nil, "nil", http.StatusNoContent)
+		jsonAPIResponse(c, task, "bulk delete run task")
 	}
 }
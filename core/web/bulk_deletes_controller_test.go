@@ -0,0 +1,116 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"chainlink/core/adapters"
+	"chainlink/core/internal/cltest"
+	"chainlink/core/store/models"
+	"chainlink/core/web"
+
+	"github.com/jinzhu/gorm"
+	"github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func bulkDeleteRuns(t *testing.T, app *cltest.TestApplication, body string) *http.Response {
+	t.Helper()
+
+	request, err := http.NewRequest("DELETE", app.Server.URL+"/v2/bulk_delete_runs", bytes.NewBufferString(body))
+	require.NoError(t, err)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(web.APIKey, cltest.APIKey)
+	request.Header.Set(web.APISecret, cltest.APISecret)
+	resp, err := http.DefaultClient.Do(request)
+	require.NoError(t, err)
+	return resp
+}
+
+func TestBulkDeletesController_Delete(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t)
+	defer cleanup()
+	app.Start()
+	client := app.NewHTTPClient()
+	app.MustSeedUserAPIKey()
+
+	job := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{{Type: adapters.TaskTypeNoOp}}
+	require.NoError(t, app.Store.CreateJob(&job))
+	var runs []models.JobRun
+	for _, status := range []models.RunStatus{models.RunStatusCompleted, models.RunStatusErrored, models.RunStatusInProgress} {
+		run := job.NewRun(job.Initiators[0])
+		run.Status = status
+		require.NoError(t, app.Store.CreateJobRun(&run))
+		require.NoError(t, app.Store.ORM.RawDB(func(db *gorm.DB) error {
+			return db.Model(&run).UpdateColumn("updated_at", cltest.ParseISO8601(t, "2018-01-01T00:00:00Z")).Error
+		}))
+		runs = append(runs, run)
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"status":        []string{"completed", "errored"},
+		"updatedBefore": "2018-01-15T00:00:00Z",
+		"jobSpecId":     job.ID.String(),
+	})
+	require.NoError(t, err)
+	resp := bulkDeleteRuns(t, app, string(body))
+	defer resp.Body.Close()
+	cltest.AssertServerResponse(t, resp, http.StatusAccepted)
+
+	var task models.BulkDeleteRunTask
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &task))
+	assert.Equal(t, 2, task.Total)
+	assert.Equal(t, job.ID, task.Query.JobSpecID)
+
+	gomega.NewGomegaWithT(t).Eventually(func() models.BulkTaskStatus {
+		resp, cleanup := client.Get("/v2/bulk_delete_runs/" + task.ID.String())
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+		var polled models.BulkDeleteRunTask
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &polled))
+		return polled.Status
+	}).Should(gomega.Equal(models.BulkTaskStatusCompleted))
+
+	_, err = app.Store.FindJobRun(runs[0].ID)
+	assert.Error(t, err)
+	_, err = app.Store.FindJobRun(runs[1].ID)
+	assert.Error(t, err)
+	_, err = app.Store.FindJobRun(runs[2].ID)
+	assert.NoError(t, err)
+}
+
+func TestBulkDeletesController_Delete_InvalidStatus(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t)
+	defer cleanup()
+	app.Start()
+	app.MustSeedUserAPIKey()
+
+	resp := bulkDeleteRuns(t, app, `{"status":["in_progress"],"updatedBefore":"2018-01-15T00:00:00Z"}`)
+	defer resp.Body.Close()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+}
+
+func TestBulkDeletesController_Show_NotFound(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t)
+	defer cleanup()
+	app.Start()
+	client := app.NewHTTPClient()
+
+	resp, cleanup := client.Get("/v2/bulk_delete_runs/" + models.NewID().String())
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+
+	resp, cleanup = client.Get("/v2/bulk_delete_runs/garbage")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+}
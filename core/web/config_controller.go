@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"chainlink/core/services"
+	"chainlink/core/store/models"
 	"chainlink/core/store/presenters"
 	"chainlink/core/utils"
 
@@ -71,6 +72,7 @@ func (cc *ConfigController) Patch(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, fmt.Errorf("failed to set gas price default: %+v", err))
 	} else {
 		response.EthGasPriceDefault.To = request.EthGasPriceDefault.String()
+		audit(c, cc.App.GetStore(), models.AuditConfigUpdated, "EthGasPriceDefault", response)
 		jsonAPIResponse(c, response, "config")
 	}
 }
@@ -44,6 +44,12 @@ func TestConfigController_Show(t *testing.T) {
 	assert.Equal(t, uint64(3), cwl.EthGasBumpThreshold)
 	assert.Equal(t, uint64(300), cwl.MinimumRequestExpiration)
 	assert.Equal(t, big.NewInt(5000000000), cwl.EthGasBumpWei)
+	assert.Equal(t, uint64(5), cwl.EthMaxHeadLag)
+	assert.Equal(t, 15*time.Second, cwl.EthHealthCheckInterval)
+	assert.Equal(t, 5*time.Second, cwl.EthPollInterval)
+	assert.Equal(t, "fixed", cwl.EthGasEstimator)
+	assert.Equal(t, big.NewInt(1500000000000), cwl.EthMaxGasPriceWei)
+	assert.False(t, cwl.EthBroadcastTransactions)
 	assert.Equal(t, big.NewInt(20000000000), cwl.EthGasPriceDefault)
 	assert.Equal(t, orm.NewConfig().LinkContractAddress(), cwl.LinkContractAddress)
 	assert.Equal(t, assets.NewLink(100), cwl.MinimumContractPayment)
@@ -0,0 +1,26 @@
+package web
+
+import (
+	"chainlink/core/services"
+	"chainlink/core/store/presenters"
+
+	"github.com/gin-gonic/gin"
+)
+
+// EthEndpointsController reports on the Ethereum nodes the node connects to.
+type EthEndpointsController struct {
+	App services.Application
+}
+
+// Index returns the health of every configured Ethereum node, marking the one
+// currently in use as active.
+// Example:
+//  "<application>/eth_endpoints"
+func (eec *EthEndpointsController) Index(c *gin.Context) {
+	endpoints := []presenters.EthEndpoint{}
+	for _, status := range eec.App.GetStore().EthEndpoints.Status() {
+		endpoints = append(endpoints, presenters.EthEndpoint{EndpointStatus: status})
+	}
+
+	jsonAPIResponse(c, endpoints, "eth endpoints")
+}
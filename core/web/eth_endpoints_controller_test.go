@@ -0,0 +1,42 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+
+	"chainlink/core/internal/cltest"
+	"chainlink/core/store/presenters"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEthEndpointsController_Index(t *testing.T) {
+	t.Parallel()
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+	config.Set("ETH_SECONDARY_URLS", "ws://user:secret@127.0.0.1:8547, ws://127.0.0.1:8548")
+	app, cleanup := cltest.NewApplicationWithConfigAndKey(t, config)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	resp, cleanup := client.Get("/v2/eth_endpoints")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var endpoints []presenters.EthEndpoint
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &endpoints))
+	require.Len(t, endpoints, 3)
+
+	assert.Equal(t, app.Store.Config.EthereumURL(), endpoints[0].URL)
+	assert.True(t, endpoints[0].Primary)
+	assert.True(t, endpoints[0].Active)
+	assert.True(t, endpoints[0].Healthy)
+
+	assert.Equal(t, "ws://127.0.0.1:8547", endpoints[1].URL)
+	assert.False(t, endpoints[1].Primary)
+	assert.False(t, endpoints[1].Active)
+	assert.Equal(t, "ws://127.0.0.1:8548", endpoints[2].URL)
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
 
 	"chainlink/core/store"
 	"chainlink/core/store/models"
@@ -87,3 +89,48 @@ func NotifyExternalInitiator(
 	}
 	return nil
 }
+
+// NotifyExternalInitiatorRemoved sends a DELETE notification to the External
+// Initiator of the previous version of a Job Spec when the new version is no
+// longer initiated by it, so that it stops initiating runs of the job.
+func NotifyExternalInitiatorRemoved(
+	previous models.JobSpec,
+	js models.JobSpec,
+	store *store.Store,
+) error {
+	initrs := previous.InitiatorsFor(models.InitiatorExternal)
+	if len(initrs) == 0 {
+		return nil
+	}
+	name := initrs[0].Name
+	for _, initr := range js.InitiatorsFor(models.InitiatorExternal) {
+		if strings.EqualFold(initr.Name, name) {
+			return nil
+		}
+	}
+
+	ei, err := store.FindExternalInitiatorByName(name)
+	if err != nil {
+		return errors.Wrap(err, "external initiator")
+	}
+	if ei.URL == nil {
+		return nil
+	}
+
+	u := url.URL(*ei.URL)
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + previous.ID.String()
+	req, err := http.NewRequest(http.MethodDelete, u.String(), nil)
+	if err != nil {
+		return errors.Wrap(err, "creating remove notification HTTP request")
+	}
+	req.Header.Set(ExternalInitiatorAccessKeyHeader, ei.OutgoingToken)
+	req.Header.Set(ExternalInitiatorSecretHeader, ei.OutgoingSecret)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "could not notify '%s' (%s)", ei.Name, ei.URL)
+	}
+	if !(resp.StatusCode >= 200 && resp.StatusCode < 300) {
+		return fmt.Errorf(" notify '%s' (%s) received bad response '%s'", ei.Name, ei.URL, resp.Status)
+	}
+	return nil
+}
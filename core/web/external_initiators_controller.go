@@ -38,6 +38,7 @@ func (eic *ExternalInitiatorsController) Create(c *gin.Context) {
 	} else if err := eic.App.GetStore().CreateExternalInitiator(ei); err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 	} else {
+		audit(c, eic.App.GetStore(), models.AuditExternalInitiatorCreated, ei.Name, eir)
 		resp := presenters.NewExternalInitiatorAuthentication(*ei, *eia)
 		jsonAPIResponseWithStatus(c, resp, "external initiator authentication", http.StatusCreated)
 	}
@@ -54,6 +55,7 @@ func (eic *ExternalInitiatorsController) Destroy(c *gin.Context) {
 	if err := eic.App.GetStore().DeleteExternalInitiator(id); err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 	} else {
+		audit(c, eic.App.GetStore(), models.AuditExternalInitiatorDeleted, id, nil)
 		jsonAPIResponseWithStatus(c, nil, "external initiator", http.StatusNoContent)
 	}
 }
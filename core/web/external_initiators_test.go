@@ -3,6 +3,7 @@ package web_test
 import (
 	"encoding/json"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"chainlink/core/auth"
@@ -182,3 +183,38 @@ func TestNotifyExternalInitiator_NotNotified(t *testing.T) {
 		})
 	}
 }
+
+func TestNotifyExternalInitiatorRemoved(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	var requests []*http.Request
+	eiMockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer eiMockServer.Close()
+
+	url := cltest.WebURL(t, eiMockServer.URL+"/jobs")
+	ei, err := models.NewExternalInitiator(auth.NewToken(), &models.ExternalInitiatorRequest{Name: "somecoin", URL: &url})
+	require.NoError(t, err)
+	require.NoError(t, store.CreateExternalInitiator(ei))
+
+	external := models.Initiator{
+		Type:            models.InitiatorExternal,
+		InitiatorParams: models.InitiatorParams{Name: "somecoin"},
+	}
+	previous := models.JobSpec{ID: models.NewID(), Initiators: []models.Initiator{external}}
+
+	kept := models.JobSpec{ID: previous.ID, Initiators: []models.Initiator{external}}
+	require.NoError(t, web.NotifyExternalInitiatorRemoved(previous, kept, store))
+	assert.Len(t, requests, 0)
+
+	removed := models.JobSpec{ID: previous.ID, Initiators: []models.Initiator{{Type: models.InitiatorWeb}}}
+	require.NoError(t, web.NotifyExternalInitiatorRemoved(previous, removed, store))
+	require.Len(t, requests, 1)
+	assert.Equal(t, http.MethodDelete, requests[0].Method)
+	assert.Equal(t, "/jobs/"+previous.ID.String(), requests[0].URL.Path)
+	assert.Equal(t, ei.OutgoingToken, requests[0].Header.Get(web.ExternalInitiatorAccessKeyHeader))
+	assert.Equal(t, ei.OutgoingSecret, requests[0].Header.Get(web.ExternalInitiatorSecretHeader))
+}
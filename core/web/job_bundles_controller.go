@@ -0,0 +1,74 @@
+package web
+
+import (
+	"net/http"
+
+	"chainlink/core/services"
+	"chainlink/core/store/models"
+	"chainlink/core/store/orm"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+// JobBundlesController exports and imports jobs along with the bridges,
+// external initiators and service agreements they depend on.
+type JobBundlesController struct {
+	App services.Application
+}
+
+// Show exports the jobs given by the jobSpecId query parameters, or every
+// job when there are none, as a bundle.
+// Example:
+//  "<application>/job_bundles?jobSpecId=<ID>&jobSpecId=<ID>"
+func (jbc *JobBundlesController) Show(c *gin.Context) {
+	var ids []*models.ID
+	for _, param := range c.QueryArray("jobSpecId") {
+		id, err := models.NewIDFromString(param)
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		ids = append(ids, id)
+	}
+
+	if bundle, err := services.ExportJobBundle(jbc.App.GetStore(), ids); errors.Cause(err) == orm.ErrorNotFound {
+		jsonAPIError(c, http.StatusNotFound, errors.New("JobSpec not found"))
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+	} else {
+		jsonAPIResponse(c, bundle, "job bundle")
+	}
+}
+
+// Create imports a bundle of jobs, returning what was added to the node.
+// External initiators are notified of the new jobs, as when a job is created.
+// Example:
+//  "<application>/job_bundles"
+func (jbc *JobBundlesController) Create(c *gin.Context) {
+	var bundle models.JobBundle
+	if err := c.ShouldBindJSON(&bundle); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	store := jbc.App.GetStore()
+	report, err := services.ImportJobBundle(jbc.App, bundle, func(js models.JobSpec) error {
+		return NotifyExternalInitiator(js, store)
+	})
+	switch e := err.(type) {
+	case nil:
+		audit(c, jbc.App.GetStore(), models.AuditJobBundleImported, "", bundle)
+		jsonAPIResponse(c, report, "job bundle import")
+	case *services.JobBundleConflictError:
+		fe := models.NewJSONAPIErrors()
+		for _, conflict := range e.Conflicts {
+			fe.Add(conflict)
+		}
+		jsonAPIError(c, http.StatusConflict, fe)
+	case *models.JSONAPIErrors:
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+	default:
+		jsonAPIError(c, http.StatusInternalServerError, err)
+	}
+}
@@ -0,0 +1,116 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"chainlink/core/auth"
+	"chainlink/core/internal/cltest"
+	"chainlink/core/store/models"
+	"chainlink/core/web"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobBundlesController_ShowCreate(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication(t)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	_, bt := cltest.NewBridgeType(t, "exportedbridge")
+	require.NoError(t, app.Store.CreateBridgeType(bt))
+	job := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{cltest.NewTask(t, "exportedbridge")}
+	require.NoError(t, app.AddJob(job))
+
+	resp, cleanup := client.Get("/v2/job_bundles?jobSpecId=" + job.ID.String())
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var bundle models.JobBundle
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &bundle))
+	require.Len(t, bundle.Jobs, 1)
+	assert.Equal(t, job.ID, bundle.Jobs[0].ID)
+	require.Len(t, bundle.Bridges, 1)
+
+	body, err := json.Marshal(bundle)
+	require.NoError(t, err)
+	resp, cleanup = client.Post("/v2/job_bundles", bytes.NewBuffer(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var report models.JobBundleImport
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &report))
+	require.Len(t, report.Jobs, 1)
+	assert.Equal(t, models.BundleImportUnchanged, report.Jobs[0].Status)
+
+	bundle.Bridges[0].Confirmations++
+	body, err = json.Marshal(bundle)
+	require.NoError(t, err)
+	resp, cleanup = client.Post("/v2/job_bundles", bytes.NewBuffer(body))
+	defer cleanup()
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	assert.Contains(t, string(cltest.ParseResponseBody(t, resp)), "exportedbridge")
+}
+
+func TestJobBundlesController_Show_NotFound(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication(t)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	resp, cleanup := client.Get("/v2/job_bundles?jobSpecId=" + models.NewID().String())
+	defer cleanup()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestJobBundlesController_Create_Invalid(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication(t)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	body := `{"jobs":[{"id":"` + models.NewID().String() + `","initiators":[{"type":"web"}],"tasks":[{"type":"idonotexist"}]}]}`
+	resp, cleanup := client.Post("/v2/job_bundles", strings.NewReader(body))
+	defer cleanup()
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	assert.Len(t, cltest.AllJobs(t, app.Store), 0)
+}
+
+func TestJobBundlesController_Create_NotifiesExternalInitiator(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication(t)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	var notice web.JobSpecNotice
+	eiMockServer, assertCalled := cltest.NewHTTPMockServer(t, http.StatusOK, "POST", "",
+		func(header http.Header, body string) {
+			require.NoError(t, json.Unmarshal([]byte(body), &notice))
+		},
+	)
+	defer assertCalled()
+
+	eiURL := cltest.WebURL(t, eiMockServer.URL)
+	ei, err := models.NewExternalInitiator(auth.NewToken(), &models.ExternalInitiatorRequest{Name: "bundledei", URL: &eiURL})
+	require.NoError(t, err)
+	require.NoError(t, app.Store.CreateExternalInitiator(ei))
+
+	id := models.NewID()
+	body := `{"jobs":[{"id":"` + id.String() + `","initiators":[{"type":"external","name":"bundledei","params":{"foo":"bar"}}],"tasks":[{"type":"noop"}]}],"externalInitiators":["bundledei"]}`
+	resp, cleanup := client.Post("/v2/job_bundles", strings.NewReader(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	assert.Equal(t, id, notice.JobID)
+	assert.Equal(t, models.InitiatorExternal, notice.Type)
+}
@@ -1,9 +1,12 @@
 package web
 
 import (
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strings"
+	"time"
 
 	"chainlink/core/services"
 	"chainlink/core/store/models"
@@ -11,6 +14,8 @@ import (
 	"chainlink/core/store/presenters"
 	"chainlink/core/utils"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
 )
@@ -20,30 +25,79 @@ type JobRunsController struct {
 	App services.Application
 }
 
-// Index returns paginated JobRuns for a given JobSpec
+// Index returns paginated JobRuns, filtered by the query parameters:
+// jobSpecId, status (which can be given more than once), initiatorType,
+// createdAfter, createdBefore, finishedAfter and finishedBefore (as RFC3339
+// times), requester, requestId, txHash and error (matching part of the
+// run's error message).
 // Example:
-//  "<application>/runs?jobSpecId=:jobSpecId&size=1&page=2"
+//  "<application>/runs?jobSpecId=:jobSpecId&status=errored&size=1&page=2"
 func (jrc *JobRunsController) Index(c *gin.Context, size, page, offset int) {
-	id := c.Query("jobSpecId")
+	filter, err := parseJobRunFilter(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
 
 	order := orm.Ascending
 	if c.Query("sort") == "-createdAt" {
 		order = orm.Descending
 	}
 
-	store := jrc.App.GetStore()
-	var runs []models.JobRun
-	var count int
-	var err error
-	if id == "" {
-		runs, count, err = store.JobRunsSorted(order, offset, size)
-	} else if runID, err := models.NewIDFromString(id); err == nil {
-		runs, count, err = store.JobRunsSortedFor(runID, order, offset, size)
-	} else {
-		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+	runs, count, err := jrc.App.GetStore().JobRunsFiltered(filter, order, offset, size)
+	paginatedResponse(c, "JobRuns", size, page, runs, count, err)
+}
+
+func parseJobRunFilter(c *gin.Context) (models.JobRunFilter, error) {
+	var filter models.JobRunFilter
+	if id := c.Query("jobSpecId"); id != "" {
+		jobSpecID, err := models.NewIDFromString(id)
+		if err != nil {
+			return filter, err
+		}
+		filter.JobSpecID = jobSpecID
 	}
 
-	paginatedResponse(c, "JobRuns", size, page, runs, count, err)
+	for _, param := range c.QueryArray("status") {
+		for _, status := range strings.Split(param, ",") {
+			filter.Statuses = append(filter.Statuses, models.RunStatus(status))
+		}
+	}
+	filter.InitiatorType = c.Query("initiatorType")
+
+	for param, t := range map[string]*time.Time{
+		"createdAfter":   &filter.CreatedAfter,
+		"createdBefore":  &filter.CreatedBefore,
+		"finishedAfter":  &filter.FinishedAfter,
+		"finishedBefore": &filter.FinishedBefore,
+	} {
+		if value := c.Query(param); value != "" {
+			parsed, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return filter, fmt.Errorf("%s must be an RFC3339 time: %v", param, err)
+			}
+			*t = parsed
+		}
+	}
+
+	if requester := c.Query("requester"); requester != "" {
+		if !common.IsHexAddress(requester) {
+			return filter, fmt.Errorf("requester %s is not an address", requester)
+		}
+		address := common.HexToAddress(requester)
+		filter.Requester = &address
+	}
+	filter.RequestID = c.Query("requestId")
+	if txHash := c.Query("txHash"); txHash != "" {
+		b, err := hexutil.Decode(txHash)
+		if err != nil || len(b) != common.HashLength {
+			return filter, fmt.Errorf("txHash %s is not a transaction hash", txHash)
+		}
+		hash := common.BytesToHash(b)
+		filter.TxHash = &hash
+	}
+	filter.Error = c.Query("error")
+	return filter, nil
 }
 
 // Create starts a new Run for the requested JobSpec.
@@ -65,6 +119,7 @@ func (jrc *JobRunsController) Create(c *gin.Context) {
 	} else if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 	} else {
+		audit(c, jrc.App.GetStore(), models.AuditRunCreated, jr.ID.String(), data)
 		jsonAPIResponse(c, presenters.JobRun{JobRun: *jr}, "job run")
 	}
 }
@@ -111,9 +166,12 @@ func (jrc *JobRunsController) Show(c *gin.Context) {
 }
 
 // Update allows external adapters to resume a JobRun, reporting the result of
-// the task and marking it no longer pending.
+// the task and marking it no longer pending. When several tasks of a run wait
+// on bridges at once, the taskRunId query parameter selects which one.
+// Callbacks from signed bridges must also carry a valid signature, which
+// covers the selected task run as well as the body.
 // Example:
-//  "<application>/runs/:RunID"
+//  "<application>/runs/:RunID?taskRunId=:TaskRunID"
 func (jrc *JobRunsController) Update(c *gin.Context) {
 	var brr models.BridgeRunResult
 
@@ -126,16 +184,30 @@ func (jrc *JobRunsController) Update(c *gin.Context) {
 		jsonAPIError(c, http.StatusNotFound, errors.New("Job Run not found"))
 	} else if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
-	} else if !jr.Status.PendingBridge() {
+	} else if !jr.AwaitingBridge() {
 		jsonAPIError(c, http.StatusMethodNotAllowed, errors.New("Cannot resume a job run that isn't pending"))
-	} else if err := c.ShouldBindJSON(&brr); err != nil {
+	} else if body, err := c.GetRawData(); err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
-	} else if bt, err := unscoped.PendingBridgeType(jr); err != nil {
+	} else if err := json.Unmarshal(body, &brr); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+	} else if err := bindTaskRunID(c, &brr); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+	} else if bt, err := unscoped.PendingBridgeType(jr, brr.TaskRunID); err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 	} else if ok, err := models.AuthenticateBridgeType(&bt, authToken); err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 	} else if !ok {
 		c.AbortWithStatus(http.StatusUnauthorized)
+	} else if err := models.VerifyBridgeSignature(
+		&bt,
+		runID,
+		brr.TaskRunID,
+		c.GetHeader(models.BridgeTimestampHeader),
+		c.GetHeader(models.BridgeSignatureHeader),
+		body,
+		time.Now(),
+	); err != nil {
+		jsonAPIError(c, http.StatusUnauthorized, err)
 	} else if err = jrc.App.ResumePending(runID, brr); errors.Cause(err) == orm.ErrorNotFound {
 		jsonAPIError(c, http.StatusNotFound, errors.New("Job Run not found"))
 	} else if err != nil {
@@ -145,6 +217,19 @@ func (jrc *JobRunsController) Update(c *gin.Context) {
 	}
 }
 
+func bindTaskRunID(c *gin.Context, brr *models.BridgeRunResult) error {
+	taskRunID := c.Query("taskRunId")
+	if taskRunID == "" {
+		return nil
+	}
+	id, err := models.NewIDFromString(taskRunID)
+	if err != nil {
+		return err
+	}
+	brr.TaskRunID = id
+	return nil
+}
+
 // Cancel stops a Run from continuing.
 // Example:
 //  "<application>/runs/:RunID/cancellation"
@@ -157,6 +242,7 @@ func (jrc *JobRunsController) Cancel(c *gin.Context) {
 	} else if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 	} else {
+		audit(c, jrc.App.GetStore(), models.AuditRunCancelled, jr.ID.String(), nil)
 		jsonAPIResponse(c, presenters.JobRun{JobRun: *jr}, "job run")
 	}
 }
@@ -2,8 +2,11 @@ package web_test
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
 	"testing"
 	"time"
 
@@ -95,6 +98,63 @@ func TestJobRunsController_Index(t *testing.T) {
 	assert.Equal(t, runA.ID, allJobRuns[2].ID, "expected runs ordered by created at descending")
 }
 
+func TestJobRunsController_Index_Filters(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t)
+	app.Start()
+	defer cleanup()
+	client := app.NewHTTPClient()
+
+	runA, runB, runC := setupJobRunsControllerIndex(t, app)
+	runB.SetError(errors.New("consumer reverted"))
+	require.NoError(t, app.Store.SaveJobRun(runB))
+	runC.Status = models.RunStatusCompleted
+	require.NoError(t, app.Store.SaveJobRun(runC))
+
+	tests := []struct {
+		name  string
+		query string
+		want  []*models.ID
+	}{
+		{"status", "status=errored", []*models.ID{runB.ID}},
+		{"statuses", "status=errored&status=completed", []*models.ID{runB.ID, runC.ID}},
+		{"comma separated statuses", "status=errored,completed", []*models.ID{runB.ID, runC.ID}},
+		{"job spec and status", "status=errored,completed&jobSpecId=" + runA.JobSpecID.String(), []*models.ID{runB.ID}},
+		{"error", "error=reverted", []*models.ID{runB.ID}},
+		{"initiator type", "initiatorType=runlog", []*models.ID{}},
+		{"created before", "createdBefore=" + url.QueryEscape(runB.CreatedAt.Format(time.RFC3339Nano)), []*models.ID{runA.ID}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, cleanup := client.Get("/v2/runs?" + test.query)
+			defer cleanup()
+			cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+			var links jsonapi.Links
+			runs := []models.JobRun{}
+			require.NoError(t, web.ParsePaginatedResponse(cltest.ParseResponseBody(t, resp), &runs, &links))
+			ids := []*models.ID{}
+			for _, run := range runs {
+				ids = append(ids, run.ID)
+			}
+			assert.Equal(t, test.want, ids)
+		})
+	}
+
+	for _, query := range []string{
+		"jobSpecId=nope",
+		"createdAfter=yesterday",
+		"requester=0x123",
+		"txHash=0x123",
+	} {
+		resp, cleanup := client.Get("/v2/runs?" + query)
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	}
+}
+
 func setupJobRunsControllerIndex(t assert.TestingT, app *cltest.TestApplication) (*models.JobRun, *models.JobRun, *models.JobRun) {
 	j1 := cltest.NewJobWithWebInitiator()
 	assert.Nil(t, app.Store.CreateJob(&j1))
@@ -204,6 +264,13 @@ func TestJobRunsController_Create_ExternalInitiator_Success(t *testing.T) {
 	jr = cltest.WaitForJobRunToComplete(t, app.Store, jr)
 	value := cltest.MustResultString(t, jr.Result)
 	assert.Equal(t, "100", value)
+
+	entries, _, err := app.Store.AuditLogEntries(models.AuditRunCreated, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, models.AuditActorExternalInitiator, entries[0].ActorType)
+	assert.Equal(t, "bitcoin", entries[0].Actor)
+	assert.Equal(t, jr.ID.String(), entries[0].Target)
 }
 
 func TestJobRunsController_Create_Archived(t *testing.T) {
@@ -363,6 +430,74 @@ func TestJobRunsController_Update_WrongAccessToken(t *testing.T) {
 	assert.Equal(t, models.RunStatusPendingBridge, jr.Status)
 }
 
+func TestJobRunsController_Update_Signed(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication(t)
+	eth := app.MockCallerSubscriberClient()
+	eth.Register("eth_chainId", app.Store.Config.ChainID())
+	app.Start()
+	defer cleanup()
+
+	tests := []struct {
+		name        string
+		secret      string
+		signTaskRun bool
+		status      int
+	}{
+		{"valid_signature", "", true, http.StatusOK},
+		{"invalid_signature", "wrongsecret", true, http.StatusUnauthorized},
+		{"unsigned_task_run", "", false, http.StatusUnauthorized},
+	}
+
+	signed := true
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bta, bt, err := models.NewBridgeType(&models.BridgeTypeRequest{
+				Name:   models.MustNewTaskType(test.name),
+				URL:    cltest.WebURL(t, "https://bridge.example.com/api"),
+				Signed: &signed,
+			})
+			require.NoError(t, err)
+			require.NoError(t, app.Store.CreateBridgeType(bt))
+			j := cltest.NewJobWithWebInitiator()
+			j.Tasks = []models.TaskSpec{{Type: bt.Name}}
+			require.NoError(t, app.Store.CreateJob(&j))
+			jr := cltest.MarkJobRunPendingBridge(j.NewRun(j.Initiators[0]), 0)
+			require.NoError(t, app.Store.CreateJobRun(&jr))
+
+			secret := bta.SigningSecret
+			if test.secret != "" {
+				secret = test.secret
+			}
+			body := fmt.Sprintf(`{"id":"%v","data":{"result": "100"}}`, jr.ID.String())
+			timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+			taskRunID := jr.TaskRuns[0].ID
+			signedTaskRunID := taskRunID
+			if !test.signTaskRun {
+				signedTaskRunID = nil
+			}
+			headers := map[string]string{
+				"Authorization":              "Bearer " + bta.IncomingToken,
+				models.BridgeTimestampHeader: timestamp,
+				models.BridgeSignatureHeader: models.SignBridgeMessage(secret, timestamp, jr.ID, signedTaskRunID, []byte(body)),
+			}
+			url := app.Config.ClientNodeURL() + "/v2/runs/" + jr.ID.String() + "?taskRunId=" + taskRunID.String()
+			resp, cleanup := cltest.UnauthenticatedPatch(t, url, bytes.NewBufferString(body), headers)
+			defer cleanup()
+			assert.Equal(t, test.status, resp.StatusCode)
+
+			if test.status == http.StatusOK {
+				jr = cltest.WaitForJobRunToComplete(t, app.Store, jr)
+				assert.Equal(t, "100", cltest.MustResultString(t, jr.Result))
+			} else {
+				jr, err = app.Store.FindJobRun(jr.ID)
+				require.NoError(t, err)
+				assert.Equal(t, models.RunStatusPendingBridge, jr.Status)
+			}
+		})
+	}
+}
+
 func TestJobRunsController_Update_NotPending(t *testing.T) {
 	t.Parallel()
 	app, cleanup := cltest.NewApplication(t)
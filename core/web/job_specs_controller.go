@@ -52,6 +52,7 @@ func (jsc *JobSpecsController) Create(c *gin.Context) {
 	} else if err = jsc.App.AddJob(js); err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 	} else {
+		audit(c, jsc.App.GetStore(), models.AuditJobCreated, js.ID.String(), jsr)
 		jsonAPIResponse(c, presenters.JobSpec{JobSpec: js}, "job")
 	}
 }
@@ -71,6 +72,53 @@ func (jsc *JobSpecsController) Show(c *gin.Context) {
 	}
 }
 
+// Update validates and saves a new version of a JobSpec under the same ID,
+// and starts it in place of the previous version.
+// Example:
+//  "<application>/specs/:SpecID"
+func (jsc *JobSpecsController) Update(c *gin.Context) {
+	var jsr models.JobSpecRequest
+	if id, err := models.NewIDFromString(c.Param("SpecID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+	} else if previous, err := jsc.App.GetStore().FindJob(id); errors.Cause(err) == orm.ErrorNotFound {
+		jsonAPIError(c, http.StatusNotFound, errors.New("JobSpec not found"))
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+	} else if err := c.ShouldBindJSON(&jsr); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+	} else if js := jobVersionFromRequest(id, jsr); false {
+	} else if err := services.ValidateJob(js, jsc.App.GetStore()); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+	} else if err := NotifyExternalInitiator(js, jsc.App.GetStore()); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+	} else if err := NotifyExternalInitiatorRemoved(previous, js, jsc.App.GetStore()); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+	} else if err = jsc.App.UpdateJob(js); errors.Cause(err) == orm.ErrServiceAgreementJob {
+		jsonAPIError(c, http.StatusConflict, err)
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+	} else if j, err := jsc.App.GetStore().FindJob(id); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+	} else {
+		audit(c, jsc.App.GetStore(), models.AuditJobUpdated, id.String(), jsr)
+		jsonAPIResponse(c, jobPresenter(jsc, j), "job")
+	}
+}
+
+// jobVersionFromRequest creates a JobSpec from a request, to replace the job
+// with the given ID.
+func jobVersionFromRequest(id *models.ID, jsr models.JobSpecRequest) models.JobSpec {
+	js := models.NewJobFromRequest(jsr)
+	js.ID = id
+	for i := range js.Initiators {
+		js.Initiators[i].JobSpecID = id
+	}
+	for i := range js.Tasks {
+		js.Tasks[i].JobSpecID = id
+	}
+	return js
+}
+
 // Destroy soft deletes a job spec.
 // Example:
 //  "<application>/specs/:SpecID"
@@ -82,6 +130,7 @@ func (jsc *JobSpecsController) Destroy(c *gin.Context) {
 	} else if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 	} else {
+		audit(c, jsc.App.GetStore(), models.AuditJobArchived, id.String(), nil)
 		jsonAPIResponseWithStatus(c, nil, "job", http.StatusNoContent)
 	}
 }
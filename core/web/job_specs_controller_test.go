@@ -10,6 +10,7 @@ import (
 
 	"chainlink/core/adapters"
 	"chainlink/core/auth"
+	"chainlink/core/eth"
 	"chainlink/core/internal/cltest"
 	"chainlink/core/store/models"
 	"chainlink/core/store/presenters"
@@ -478,3 +479,72 @@ func TestJobSpecsController_Destroy(t *testing.T) {
 	assert.Error(t, utils.JustError(app.Store.FindJob(job.ID)))
 	assert.Equal(t, 0, len(app.ChainlinkApplication.JobSubscriber.Jobs()))
 }
+
+func TestJobSpecsController_Update(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication(t)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	ethMock := cltest.MockEthOnStore(t, app.GetStore())
+	ethMock.Register("eth_getLogs", []eth.Log{})
+	ethMock.RegisterSubscription("logs")
+
+	client := app.NewHTTPClient()
+	job := cltest.NewJobWithLogInitiator()
+	require.NoError(t, app.AddJob(job))
+	require.Len(t, app.ChainlinkApplication.JobSubscriber.Jobs(), 1)
+
+	resp, cleanup := client.Patch("/v2/specs/"+job.ID.String(), bytes.NewBuffer(cltest.MustReadFile(t, "testdata/hello_world_job.json")))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var j models.JobSpec
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &j))
+	assert.Equal(t, job.ID, j.ID)
+	assert.Equal(t, uint32(2), j.Version)
+	require.Len(t, j.Initiators, 1)
+	assert.Equal(t, models.InitiatorWeb, j.Initiators[0].Type)
+
+	j, err := app.Store.FindJob(job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), j.Version)
+	require.Len(t, j.Tasks, 4)
+
+	// The web initiated version no longer subscribes to logs
+	assert.Len(t, app.ChainlinkApplication.JobSubscriber.Jobs(), 0)
+}
+
+func TestJobSpecsController_Update_NotFound(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication(t)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	client := app.NewHTTPClient()
+	resp, cleanup := client.Patch("/v2/specs/"+models.NewID().String(), bytes.NewBuffer(cltest.MustReadFile(t, "testdata/hello_world_job.json")))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
+
+func TestJobSpecsController_Update_ServiceAgreement(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication(t)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	client := app.NewHTTPClient()
+	sa := models.ServiceAgreement{
+		ID:      "0x" + strings.Repeat("ab", 32),
+		JobSpec: cltest.NewJobWithWebInitiator(),
+	}
+	require.NoError(t, app.Store.CreateServiceAgreement(&sa))
+
+	resp, cleanup := client.Patch("/v2/specs/"+sa.JobSpec.ID.String(), bytes.NewBuffer(cltest.MustReadFile(t, "testdata/hello_world_job.json")))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusConflict)
+
+	j, err := app.Store.FindJob(sa.JobSpec.ID)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), j.Version)
+}
@@ -29,6 +29,7 @@ func (kc *KeysController) Create(c *gin.Context) {
 	} else if err := kc.App.GetStore().SyncDiskKeyStoreToDB(); err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 	} else {
+		audit(c, kc.App.GetStore(), models.AuditKeyCreated, account.Address.Hex(), nil)
 		jsonAPIResponseWithStatus(c, presenters.NewAccount{Account: &account}, "account", http.StatusCreated)
 	}
 }
@@ -27,6 +27,9 @@ import (
 	"github.com/gin-gonic/contrib/sessions"
 	"github.com/gin-gonic/gin"
 	"github.com/gobuffalo/packr"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/ulule/limiter"
 	mgin "github.com/ulule/limiter/drivers/middleware/gin"
 	"github.com/ulule/limiter/drivers/store/memory"
@@ -50,6 +53,9 @@ const (
 	SessionUserKey = "user"
 	// SessionExternalInitiatorKey is the External Initiator key in the session map
 	SessionExternalInitiatorKey = "external_initiator"
+	// SessionAPITokenKey is set in the session map when the User
+	// authenticated with their API token
+	SessionAPITokenKey = "api_token"
 )
 
 func explorerStatus(app services.Application) gin.HandlerFunc {
@@ -150,6 +156,14 @@ func metricRoutes(app services.Application, r *gin.RouterGroup) {
 	group := r.Group("/debug", RequireAuth(app.GetStore(), AuthenticateBySession))
 	group.GET("/vars", expvar.Handler())
 
+	if app.GetStore().Config.MetricsEnabled() {
+		// No authentication so that Prometheus can scrape the node
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(services.NewRunsCollector(app.GetStore().ORM))
+		gatherers := prometheus.Gatherers{prometheus.DefaultGatherer, registry}
+		r.GET("/metrics", gin.WrapH(promhttp.HandlerFor(gatherers, promhttp.HandlerOpts{})))
+	}
+
 	if app.GetStore().Config.Dev() {
 		// No authentication because `go tool pprof` doesn't support it
 		pprofGroup := r.Group("/debug/pprof")
@@ -206,11 +220,18 @@ func v2Routes(app services.Application, r *gin.RouterGroup) {
 		authv2.POST("/external_initiators", eia.Create)
 		authv2.DELETE("/external_initiators/:AccessKey", eia.Destroy)
 
+		sim := SimulationsController{app}
 		authv2.POST("/specs", j.Create)
+		authv2.POST("/specs/:SpecID", specAction("simulate", sim.Create))
 		authv2.GET("/specs", paginatedRequest(j.Index))
 		authv2.GET("/specs/:SpecID", j.Show)
+		authv2.PATCH("/specs/:SpecID", j.Update)
 		authv2.DELETE("/specs/:SpecID", j.Destroy)
 
+		jb := JobBundlesController{app}
+		authv2.GET("/job_bundles", jb.Show)
+		authv2.POST("/job_bundles", jb.Create)
+
 		authv2.GET("/runs", paginatedRequest(jr.Index))
 		authv2.GET("/runs/:RunID", jr.Show)
 		authv2.PUT("/runs/:RunID/cancellation", jr.Cancel)
@@ -224,6 +245,9 @@ func v2Routes(app services.Application, r *gin.RouterGroup) {
 		authv2.PATCH("/bridge_types/:BridgeName", bt.Update)
 		authv2.DELETE("/bridge_types/:BridgeName", bt.Destroy)
 
+		tt := TaskTypesController{app}
+		authv2.GET("/task_types", tt.Index)
+
 		w := WithdrawalsController{app}
 		authv2.POST("/withdrawals", w.Create)
 
@@ -239,6 +263,9 @@ func v2Routes(app services.Application, r *gin.RouterGroup) {
 		authv2.GET("/config", cc.Show)
 		authv2.PATCH("/config", cc.Patch)
 
+		eec := EthEndpointsController{app}
+		authv2.GET("/eth_endpoints", eec.Index)
+
 		tas := TxAttemptsController{app}
 		authv2.GET("/tx_attempts", paginatedRequest(tas.Index))
 
@@ -246,8 +273,12 @@ func v2Routes(app services.Application, r *gin.RouterGroup) {
 		authv2.GET("/transactions", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/:TxHash", txs.Show)
 
+		alc := AuditLogController{app}
+		authv2.GET("/audit_log", paginatedRequest(alc.Index))
+
 		bdc := BulkDeletesController{app}
 		authv2.DELETE("/bulk_delete_runs", bdc.Delete)
+		authv2.GET("/bulk_delete_runs/:TaskID", bdc.Show)
 	}
 
 	ping := PingController{app}
@@ -438,3 +469,17 @@ func isBlacklisted(k string) bool {
 	}
 	return false
 }
+
+// specAction serves the handler at "/specs/<action>". Gin cannot route a
+// static path next to the ":SpecID" wildcard of "/specs/:SpecID/runs", so the
+// action is matched against the wildcard instead, and any other ID is not
+// found.
+func specAction(action string, handler gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Param("SpecID") != action {
+			jsonAPIError(c, http.StatusNotFound, errors.New("Not found"))
+			return
+		}
+		handler(c)
+	}
+}
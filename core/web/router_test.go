@@ -2,6 +2,8 @@ package web_test
 
 import (
 	"bytes"
+	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -187,3 +189,48 @@ func TestRouter_GinHelmetHeaders(t *testing.T) {
 			"wrong header for helmet's %s handler", tt.HelmetName)
 	}
 }
+
+func TestRouter_Metrics(t *testing.T) {
+	config, cfgCleanup := cltest.NewConfig(t)
+	defer cfgCleanup()
+	config.Set("METRICS_ENABLED", true)
+	app, cleanup := cltest.NewApplicationWithConfigAndKey(t, config)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	j := cltest.NewJobWithWebInitiator()
+	require.NoError(t, app.Store.CreateJob(&j))
+	jr := j.NewRun(j.Initiators[0])
+	jr.Status = models.RunStatusCompleted
+	require.NoError(t, app.Store.CreateJobRun(&jr))
+
+	router := web.Router(app)
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+
+	resp, err := http.Get(ts.URL + "/metrics")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), fmt.Sprintf(`job_runs{job_spec_id="%s",status="completed"} 1`, j.ID))
+	assert.Contains(t, string(body), "run_queue_workers")
+	assert.Contains(t, string(body), "head_tracker_current_head")
+}
+
+func TestRouter_MetricsDisabled(t *testing.T) {
+	app, cleanup := cltest.NewApplicationWithKey(t)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	router := web.Router(app)
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+
+	resp, err := http.Get(ts.URL + "/metrics")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
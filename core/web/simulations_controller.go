@@ -0,0 +1,55 @@
+package web
+
+import (
+	"net/http"
+
+	"chainlink/core/services"
+	"chainlink/core/store/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SimulationsController runs JobSpecs without saving them.
+type SimulationsController struct {
+	App services.Application
+}
+
+// Create validates a JobSpec and runs its tasks with the given input,
+// without saving the job or its run, calling bridges or sending transactions.
+// Example:
+//  "<application>/specs/simulate"
+func (sc *SimulationsController) Create(c *gin.Context) {
+	var jsr models.JobSimulationRequest
+	if err := c.ShouldBindJSON(&jsr); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+	} else if js := models.NewJobFromRequest(jsr.JobSpecRequest); false {
+	} else if err := services.ValidateJob(js, sc.App.GetStore()); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+	} else {
+		tasks := services.SimulateJob(c.Request.Context(), sc.App.GetStore(), js, jsr.Input)
+		jsonAPIResponse(c, &JobSimulation{ID: js.ID.String(), Tasks: tasks}, "simulation")
+	}
+}
+
+// JobSimulation is the output of each task of a simulated JobSpec.
+type JobSimulation struct {
+	ID    string                    `json:"-"`
+	Tasks []services.TaskSimulation `json:"tasks"`
+}
+
+// GetID returns the jsonapi ID.
+func (js JobSimulation) GetID() string {
+	return js.ID
+}
+
+// GetName returns the collection name for jsonapi.
+func (JobSimulation) GetName() string {
+	return "simulations"
+}
+
+// SetID is used to conform to the UnmarshallIdentifier interface for
+// deserializing from jsonapi documents.
+func (js *JobSimulation) SetID(value string) error {
+	js.ID = value
+	return nil
+}
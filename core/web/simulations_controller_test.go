@@ -0,0 +1,68 @@
+package web_test
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"chainlink/core/internal/cltest"
+	"chainlink/core/store/models"
+	"chainlink/core/web"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulationsController_Create(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication(t)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	_, bt := cltest.NewBridgeType(t, "simulatedbridge")
+	require.NoError(t, app.Store.CreateBridgeType(bt))
+
+	client := app.NewHTTPClient()
+
+	body := `{"initiators":[{"type":"web"}],"tasks":[{"type":"simulatedbridge"},{"type":"multiply","params":{"times":10}}],"input":{"result":"4.2"}}`
+	resp, cleanup := client.Post("/v2/specs/simulate", bytes.NewBufferString(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var simulation web.JobSimulation
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &simulation))
+	require.Len(t, simulation.Tasks, 2)
+	assert.True(t, simulation.Tasks[0].Stubbed)
+	assert.Equal(t, models.RunStatusCompleted, simulation.Tasks[1].Status)
+	assert.Equal(t, "42", simulation.Tasks[1].Result.Get("result").String())
+
+	assert.Len(t, cltest.AllJobs(t, app.Store), 0)
+}
+
+func TestSimulationsController_Create_InvalidJob(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication(t)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	client := app.NewHTTPClient()
+
+	body := `{"initiators":[{"type":"web"}],"tasks":[{"type":"idonotexist"}]}`
+	resp, cleanup := client.Post("/v2/specs/simulate", bytes.NewBufferString(body))
+	defer cleanup()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestSimulationsController_Create_OtherPathNotFound(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication(t)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	client := app.NewHTTPClient()
+
+	body := `{"initiators":[{"type":"web"}],"tasks":[{"type":"noop"}]}`
+	resp, cleanup := client.Post("/v2/specs/"+models.NewID().String(), bytes.NewBufferString(body))
+	defer cleanup()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
@@ -0,0 +1,32 @@
+package web
+
+import (
+	"chainlink/core/adapters"
+	"chainlink/core/services"
+	"chainlink/core/store/presenters"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TaskTypesController lists the core adapters available to job specs.
+type TaskTypesController struct {
+	App services.Application
+}
+
+// Index returns every registered adapter along with its defaults.
+// Example:
+//  "<application>/task_types"
+func (ttc *TaskTypesController) Index(c *gin.Context) {
+	config := ttc.App.GetStore().Config
+	taskTypes := []presenters.TaskType{}
+	for _, r := range adapters.Registrations() {
+		taskTypes = append(taskTypes, presenters.TaskType{
+			Type:               r.TaskType,
+			MinConfs:           r.MinConfsFor(config),
+			MinContractPayment: r.MinContractPaymentFor(config),
+			DevOnly:            r.DevOnly,
+		})
+	}
+
+	jsonAPIResponse(c, taskTypes, "task types")
+}
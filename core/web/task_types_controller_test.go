@@ -0,0 +1,49 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+
+	"chainlink/core/adapters"
+	"chainlink/core/assets"
+	"chainlink/core/internal/cltest"
+	"chainlink/core/store/presenters"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskTypesController_Index(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKey(t)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	resp, cleanup := client.Get("/v2/task_types")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var taskTypes []presenters.TaskType
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &taskTypes))
+	assert.Len(t, taskTypes, len(adapters.Registrations()))
+
+	found := map[string]presenters.TaskType{}
+	for _, tt := range taskTypes {
+		found[tt.Type.String()] = tt
+	}
+
+	noop, ok := found["noop"]
+	require.True(t, ok)
+	assert.Equal(t, uint32(1), noop.MinConfs)
+	assert.Equal(t, assets.NewLink(0), noop.MinContractPayment)
+
+	ethtx, ok := found["ethtx"]
+	require.True(t, ok)
+	assert.Equal(t, assets.NewLink(100), ethtx.MinContractPayment)
+
+	sleep, ok := found["sleep"]
+	require.True(t, ok)
+	assert.True(t, sleep.DevOnly)
+}
@@ -35,6 +35,7 @@ func (tc *TransfersController) Create(c *gin.Context) {
 	} else if tx, err := store.TxManager.CreateTxWithEth(from, tr.DestinationAddress, tr.Amount); err != nil {
 		jsonAPIError(c, http.StatusBadRequest, fmt.Errorf("Transaction failed: %v", err))
 	} else {
+		audit(c, store, models.AuditEthTransferred, tx.Hash.Hex(), tr)
 		jsonAPIResponse(c, presenters.NewTx(tx), "transaction")
 	}
 }
@@ -33,6 +33,7 @@ func (c *UserController) UpdatePassword(ctx *gin.Context) {
 	} else if err := c.updateUserPassword(ctx, &user, request.NewPassword); err != nil {
 		jsonAPIError(ctx, http.StatusInternalServerError, err)
 	} else {
+		audit(ctx, c.App.GetStore(), models.AuditPasswordChanged, user.Email, nil)
 		jsonAPIResponse(ctx, presenters.UserPresenter{User: &user}, "user")
 	}
 }
@@ -51,6 +52,7 @@ func (c *UserController) NewAPIToken(ctx *gin.Context) {
 	} else if err := c.App.GetStore().SaveUser(&user); err != nil {
 		jsonAPIError(ctx, http.StatusInternalServerError, err)
 	} else {
+		audit(ctx, c.App.GetStore(), models.AuditAPITokenCreated, user.Email, nil)
 		jsonAPIResponseWithStatus(ctx, newToken, "auth_token", http.StatusCreated)
 	}
 }
@@ -69,6 +71,7 @@ func (c *UserController) DeleteAPIToken(ctx *gin.Context) {
 	} else if err := c.App.GetStore().SaveUser(&user); err != nil {
 		jsonAPIError(ctx, http.StatusInternalServerError, err)
 	} else {
+		audit(ctx, c.App.GetStore(), models.AuditAPITokenDeleted, user.Email, nil)
 		jsonAPIResponseWithStatus(ctx, nil, "auth_token", http.StatusNoContent)
 	}
 }
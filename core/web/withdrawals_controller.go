@@ -57,6 +57,7 @@ func (abc *WithdrawalsController) Create(c *gin.Context) {
 	if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 	} else {
+		audit(c, store, models.AuditLinkWithdrawn, hash.Hex(), wr)
 		jsonAPIResponse(c, presenters.NewTx(&models.Tx{Hash: hash}), "transaction")
 	}
 }